@@ -0,0 +1,217 @@
+// Package analytics computes aggregate statistics about the strains in the
+// catalog of The Strain API, for dashboards and reports:
+//
+//	strains, err := client.ListAllStrains()
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	stats := analytics.Compute(strains)
+//	fmt.Println(stats.TopFlavors(5))
+//	stats.WriteCSV(os.Stdout)
+//
+// FlavorEffectRules, EffectEffectRules and AssociationRules mine rules such
+// as "strains with the Citrus flavor tend to be Energetic", with their
+// support, confidence and lift.
+//
+// KMeans and Hierarchical group strains with similar flavors and effects
+// into clusters, with the flavors and effects that define each one.
+//
+// Strains are normalized with strainapiclient.NormalizeStrain first, so a
+// flavor or effect is counted once per strain whatever its capitalization.
+package analytics
+
+import (
+	"sort"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+// RaceCount is the number of strains of a Race.
+type RaceCount struct {
+	Race  strainapiclient.Race `json:"race"`
+	Count int                  `json:"count"`
+}
+
+// FlavorCount is the number of strains with a flavor.
+type FlavorCount struct {
+	Flavor strainapiclient.Flavor `json:"flavor"`
+	Count  int                    `json:"count"`
+}
+
+// EffectCount is the number of strains with an effect.
+type EffectCount struct {
+	Type   strainapiclient.EffectType `json:"type"`
+	Effect string                     `json:"effect"`
+	Count  int                        `json:"count"`
+}
+
+// CoOccurrence is the number of strains with both a flavor and an effect.
+type CoOccurrence struct {
+	Flavor strainapiclient.Flavor     `json:"flavor"`
+	Type   strainapiclient.EffectType `json:"type"`
+	Effect string                     `json:"effect"`
+	Count  int                        `json:"count"`
+}
+
+// Stats are the aggregates of a set of strains.  Every list is sorted from
+// the highest count to the lowest, and then by name.
+type Stats struct {
+	Strains       int                                          `json:"strains"`
+	Races         []RaceCount                                  `json:"races"`
+	Flavors       []FlavorCount                                `json:"flavors"`
+	Effects       map[strainapiclient.EffectType][]EffectCount `json:"effects"`
+	CoOccurrences []CoOccurrence                               `json:"co_occurrences"`
+
+	AverageFlavorsPerStrain float64 `json:"average_flavors_per_strain"`
+	AverageEffectsPerStrain float64 `json:"average_effects_per_strain"`
+
+	// AverageEffectsPerStrainByType is the average number of effects of
+	// each EffectType, over all strains.
+	AverageEffectsPerStrainByType map[strainapiclient.EffectType]float64 `json:"average_effects_per_strain_by_type"`
+}
+
+type effectKey struct {
+	effectType strainapiclient.EffectType
+	effect     string
+}
+
+type coOccurrenceKey struct {
+	flavor strainapiclient.Flavor
+	effectKey
+}
+
+// Compute returns the Stats of strains.
+func Compute(strains strainapiclient.ListAllStrainsResult) Stats {
+	races := make(map[strainapiclient.Race]int)
+	flavors := make(map[strainapiclient.Flavor]int)
+	effects := make(map[effectKey]int)
+	coOccurrences := make(map[coOccurrenceKey]int)
+	effectsByType := make(map[strainapiclient.EffectType]int)
+	totalFlavors, totalEffects := 0, 0
+
+	for _, strain := range strains {
+		normalized, _ := strainapiclient.NormalizeStrain(strain)
+
+		races[normalized.Race]++
+
+		for _, flavor := range normalized.Flavors {
+			flavors[flavor]++
+		}
+		totalFlavors += len(normalized.Flavors)
+
+		for effectType, names := range normalized.Effects {
+			for _, name := range names {
+				key := effectKey{effectType: effectType, effect: name}
+				effects[key]++
+
+				for _, flavor := range normalized.Flavors {
+					coOccurrences[coOccurrenceKey{flavor: flavor, effectKey: key}]++
+				}
+			}
+			effectsByType[effectType] += len(names)
+			totalEffects += len(names)
+		}
+	}
+
+	stats := Stats{
+		Strains:                       len(strains),
+		Races:                         make([]RaceCount, 0, len(races)),
+		Flavors:                       make([]FlavorCount, 0, len(flavors)),
+		Effects:                       make(map[strainapiclient.EffectType][]EffectCount),
+		CoOccurrences:                 make([]CoOccurrence, 0, len(coOccurrences)),
+		AverageEffectsPerStrainByType: make(map[strainapiclient.EffectType]float64, len(effectsByType)),
+	}
+
+	for race, count := range races {
+		stats.Races = append(stats.Races, RaceCount{Race: race, Count: count})
+	}
+	sort.Slice(stats.Races, func(i, j int) bool {
+		return byCount(stats.Races[i].Count, stats.Races[j].Count, string(stats.Races[i].Race), string(stats.Races[j].Race))
+	})
+
+	for flavor, count := range flavors {
+		stats.Flavors = append(stats.Flavors, FlavorCount{Flavor: flavor, Count: count})
+	}
+	sort.Slice(stats.Flavors, func(i, j int) bool {
+		return byCount(stats.Flavors[i].Count, stats.Flavors[j].Count, string(stats.Flavors[i].Flavor), string(stats.Flavors[j].Flavor))
+	})
+
+	for key, count := range effects {
+		stats.Effects[key.effectType] = append(stats.Effects[key.effectType], EffectCount{Type: key.effectType, Effect: key.effect, Count: count})
+	}
+	for _, effectCounts := range stats.Effects {
+		sort.Slice(effectCounts, func(i, j int) bool {
+			return byCount(effectCounts[i].Count, effectCounts[j].Count, effectCounts[i].Effect, effectCounts[j].Effect)
+		})
+	}
+
+	for key, count := range coOccurrences {
+		stats.CoOccurrences = append(stats.CoOccurrences, CoOccurrence{Flavor: key.flavor, Type: key.effectType, Effect: key.effect, Count: count})
+	}
+	sort.Slice(stats.CoOccurrences, func(i, j int) bool {
+		first, second := stats.CoOccurrences[i], stats.CoOccurrences[j]
+		if first.Count != second.Count {
+			return first.Count > second.Count
+		}
+		if first.Flavor != second.Flavor {
+			return first.Flavor < second.Flavor
+		}
+		if first.Type != second.Type {
+			return first.Type < second.Type
+		}
+		return first.Effect < second.Effect
+	})
+
+	if len(strains) > 0 {
+		stats.AverageFlavorsPerStrain = float64(totalFlavors) / float64(len(strains))
+		stats.AverageEffectsPerStrain = float64(totalEffects) / float64(len(strains))
+		for effectType, count := range effectsByType {
+			stats.AverageEffectsPerStrainByType[effectType] = float64(count) / float64(len(strains))
+		}
+	}
+
+	return stats
+}
+
+// byCount orders by count from highest to lowest, and then by name.
+func byCount(firstCount int, secondCount int, firstName string, secondName string) bool {
+	if firstCount != secondCount {
+		return firstCount > secondCount
+	}
+	return firstName < secondName
+}
+
+// TopFlavors returns the n most common flavors, or all of them if there
+// are fewer, and none if n is not positive.
+func (s Stats) TopFlavors(n int) []FlavorCount {
+	return s.Flavors[:clampTop(n, len(s.Flavors))]
+}
+
+// TopEffects returns the n most common effects of an EffectType, or all of
+// them if there are fewer, and none if n is not positive.
+func (s Stats) TopEffects(effectType strainapiclient.EffectType, n int) []EffectCount {
+	effects := s.Effects[effectType]
+	return effects[:clampTop(n, len(effects))]
+}
+
+func clampTop(n int, length int) int {
+	if n < 0 {
+		return 0
+	}
+	if n > length {
+		return length
+	}
+	return n
+}
+
+// CoOccurrencesOf returns the effects found with flavor, from the most
+// common.
+func (s Stats) CoOccurrencesOf(flavor strainapiclient.Flavor) []CoOccurrence {
+	found := make([]CoOccurrence, 0)
+	for _, coOccurrence := range s.CoOccurrences {
+		if coOccurrence.Flavor == flavor {
+			found = append(found, coOccurrence)
+		}
+	}
+	return found
+}
@@ -0,0 +1,137 @@
+package analytics
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+func knownStats(t *testing.T) Stats {
+	strains, err := clienttest.NewFixtureClient().ListAllStrains()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Compute(strains)
+}
+
+func TestCompute(t *testing.T) {
+	stats := knownStats(t)
+
+	if stats.Strains != 5 {
+		t.Errorf("Expected 5 strains, got %d", stats.Strains)
+	}
+
+	expectedRaces := []RaceCount{
+		{Race: strainapiclient.RaceIndica, Count: 2},
+		{Race: strainapiclient.RaceSativa, Count: 2},
+		{Race: strainapiclient.RaceHybrid, Count: 1},
+	}
+	if diff := cmp.Diff(expectedRaces, stats.Races); diff != "" {
+		t.Errorf("Unexpected races (-expected +got):\n%s", diff)
+	}
+
+	expectedFlavors := []FlavorCount{{Flavor: "Earthy", Count: 3}, {Flavor: "Citrus", Count: 2}}
+	if diff := cmp.Diff(expectedFlavors, stats.TopFlavors(2)); diff != "" {
+		t.Errorf("Unexpected top flavors (-expected +got):\n%s", diff)
+	}
+
+	expectedEffects := []EffectCount{
+		{Type: strainapiclient.EffectTypeMedical, Effect: "Stress", Count: 5},
+		{Type: strainapiclient.EffectTypeMedical, Effect: "Depression", Count: 3},
+	}
+	if diff := cmp.Diff(expectedEffects, stats.TopEffects(strainapiclient.EffectTypeMedical, 2)); diff != "" {
+		t.Errorf("Unexpected top medical effects (-expected +got):\n%s", diff)
+	}
+
+	earthy := stats.CoOccurrencesOf("Earthy")
+	expectedCoOccurrences := []CoOccurrence{
+		{Flavor: "Earthy", Type: strainapiclient.EffectTypeMedical, Effect: "Stress", Count: 3},
+		{Flavor: "Earthy", Type: strainapiclient.EffectTypePositive, Effect: "Happy", Count: 3},
+	}
+	if diff := cmp.Diff(expectedCoOccurrences, earthy[:2]); diff != "" {
+		t.Errorf("Unexpected effects found with Earthy (-expected +got):\n%s", diff)
+	}
+
+	if stats.AverageEffectsPerStrain != 43.0/5 || stats.AverageFlavorsPerStrain != 14.0/5 {
+		t.Errorf("Unexpected averages %v and %v", stats.AverageEffectsPerStrain, stats.AverageFlavorsPerStrain)
+	}
+	if stats.AverageEffectsPerStrainByType[strainapiclient.EffectTypeNegative] != 8.0/5 {
+		t.Errorf("Unexpected average of negative effects %v", stats.AverageEffectsPerStrainByType)
+	}
+}
+
+func TestComputeEmpty(t *testing.T) {
+	stats := Compute(strainapiclient.ListAllStrainsResult{})
+	if stats.Strains != 0 || stats.AverageEffectsPerStrain != 0 || len(stats.TopFlavors(3)) != 0 {
+		t.Errorf("Expected empty stats, got %+v", stats)
+	}
+}
+
+func TestTopWithoutPositiveN(t *testing.T) {
+	stats := knownStats(t)
+
+	for _, n := range []int{0, -1} {
+		if top := stats.TopFlavors(n); len(top) != 0 {
+			t.Errorf("Expected no top flavors for %d, got %v", n, top)
+		}
+		if top := stats.TopEffects(strainapiclient.EffectTypeMedical, n); len(top) != 0 {
+			t.Errorf("Expected no top medical effects for %d, got %v", n, top)
+		}
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := knownStats(t).WriteCSV(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(&buffer).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(CSVHeader, records[0]); diff != "" {
+		t.Errorf("Unexpected header (-expected +got):\n%s", diff)
+	}
+
+	lines := make(map[string]bool)
+	for _, record := range records[1:] {
+		lines[strings.Join(record, ",")] = true
+	}
+	for _, expected := range []string{
+		"strains,,,5",
+		"race,indica,,2",
+		"flavor,Earthy,,3",
+		"effect,positive,Happy,5",
+		"co_occurrence,Earthy,positive/Happy,3",
+		"average_effects_per_strain,,,8.60",
+		"average_effects_per_strain_by_type,negative,,1.60",
+	} {
+		if !lines[expected] {
+			t.Errorf("Expected the row %q", expected)
+		}
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	stats := knownStats(t)
+
+	var buffer bytes.Buffer
+	if err := stats.WriteJSON(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded Stats
+	if err := json.Unmarshal(buffer.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(stats, decoded); diff != "" {
+		t.Errorf("Expected the JSON to round trip (-expected +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,490 @@
+package analytics
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+// ErrInvalidClusterCount is returned when the number of clusters asked for
+// is less than 1 or more than the number of strains.
+var ErrInvalidClusterCount = errors.New("Invalid number of clusters")
+
+// DefaultMaxIterations is the number of rounds KMeans runs when
+// ClusterOptions.MaxIterations is 0.
+const DefaultMaxIterations = 100
+
+// DefaultDefiningItems is the number of defining flavors, and of defining
+// effects of each EffectType, listed for a Cluster when
+// ClusterOptions.DefiningItems is 0.
+const DefaultDefiningItems = 3
+
+// ClusterOptions configure KMeans and Hierarchical.
+type ClusterOptions struct {
+	// K is the number of clusters.
+	K int
+
+	// Seed picks the first centers for KMeans; the same seed and strains
+	// always give the same clusters.  Hierarchical does not need one.
+	Seed int64
+
+	// MaxIterations limits the rounds of KMeans.
+	MaxIterations int
+
+	// DefiningItems is the most defining flavors, and defining effects of
+	// each EffectType, listed per cluster.
+	DefiningItems int
+}
+
+// Cluster is a group of strains with a similar profile.  Its defining
+// flavors and effects are those at least half of its strains have and
+// that are more common in it than in all strains, from the most
+// characteristic.
+type Cluster struct {
+	StrainIDs []int                                   `json:"strain_ids"`
+	Races     []RaceCount                             `json:"races"`
+	Flavors   []strainapiclient.Flavor                `json:"flavors"`
+	Effects   map[strainapiclient.EffectType][]string `json:"effects"`
+}
+
+func (c Cluster) String() string {
+	return fmt.Sprintf("%d strains %v: %v %v", len(c.StrainIDs), c.Races, c.Flavors, c.Effects)
+}
+
+// profiles are the strains as vectors of 0 and 1 for each flavor and
+// effect, in order of ID.
+type profiles struct {
+	ids     []int
+	races   []strainapiclient.Race
+	items   []Item
+	vectors [][]float64
+}
+
+func newProfiles(strains strainapiclient.ListAllStrainsResult) profiles {
+	normalizedStrains := make([]strainapiclient.NormalizedStrain, 0, len(strains))
+	for _, strain := range strains {
+		normalized, _ := strainapiclient.NormalizeStrain(strain)
+		normalizedStrains = append(normalizedStrains, normalized)
+	}
+	sort.Slice(normalizedStrains, func(i, j int) bool { return normalizedStrains[i].ID < normalizedStrains[j].ID })
+
+	itemSets := make([][]Item, len(normalizedStrains))
+	seen := make(map[Item]bool)
+	for index, strain := range normalizedStrains {
+		for _, flavor := range strain.Flavors {
+			itemSets[index] = append(itemSets[index], FlavorItem(flavor))
+		}
+		for effectType, names := range strain.Effects {
+			for _, name := range names {
+				itemSets[index] = append(itemSets[index], EffectItem(effectType, name))
+			}
+		}
+		for _, item := range itemSets[index] {
+			seen[item] = true
+		}
+	}
+
+	p := profiles{
+		ids:     make([]int, len(normalizedStrains)),
+		races:   make([]strainapiclient.Race, len(normalizedStrains)),
+		items:   make([]Item, 0, len(seen)),
+		vectors: make([][]float64, len(normalizedStrains)),
+	}
+	for item := range seen {
+		p.items = append(p.items, item)
+	}
+	sort.Slice(p.items, func(i, j int) bool { return p.items[i].String() < p.items[j].String() })
+
+	itemIndexes := make(map[Item]int, len(p.items))
+	for index, item := range p.items {
+		itemIndexes[item] = index
+	}
+
+	for index, strain := range normalizedStrains {
+		p.ids[index] = strain.ID
+		p.races[index] = strain.Race
+		p.vectors[index] = make([]float64, len(p.items))
+		for _, item := range itemSets[index] {
+			p.vectors[index][itemIndexes[item]] = 1
+		}
+	}
+
+	return p
+}
+
+// jaccardDistance returns 1 minus the weighted Jaccard similarity of a and
+// b, which for vectors of 0 and 1 is the Jaccard distance of the sets of
+// flavors and effects.
+func jaccardDistance(a []float64, b []float64) float64 {
+	sumMin, sumMax := 0.0, 0.0
+	for index := range a {
+		sumMin += math.Min(a[index], b[index])
+		sumMax += math.Max(a[index], b[index])
+	}
+
+	if sumMax == 0 {
+		return 0
+	}
+	return 1 - sumMin/sumMax
+}
+
+func checkClusterCount(k int, strains int) error {
+	if k < 1 || k > strains {
+		return fmt.Errorf("%w: %d clusters of %d strains", ErrInvalidClusterCount, k, strains)
+	}
+	return nil
+}
+
+// KMeans groups strains into options.K clusters with k-means, using the
+// Jaccard distance between their flavors and effects and picking the
+// first centers with k-means++ seeded by options.Seed.  Clusters are in
+// order of their lowest strain ID; a cluster that ends up without strains
+// is left out.
+func KMeans(strains strainapiclient.ListAllStrainsResult, options ClusterOptions) ([]Cluster, error) {
+	if err := checkClusterCount(options.K, len(strains)); err != nil {
+		return nil, err
+	}
+	maxIterations := options.MaxIterations
+	if maxIterations <= 0 {
+		maxIterations = DefaultMaxIterations
+	}
+
+	p := newProfiles(strains)
+	random := rand.New(rand.NewSource(options.Seed))
+	centers := initialCenters(p.vectors, options.K, random)
+
+	assignments := make([]int, len(p.vectors))
+	for iteration := 0; iteration < maxIterations; iteration++ {
+		changed := false
+		for index, vector := range p.vectors {
+			nearest := nearestCenter(vector, centers)
+			if iteration == 0 || nearest != assignments[index] {
+				assignments[index] = nearest
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+
+		updateCenters(centers, p.vectors, assignments)
+	}
+
+	return p.clusters(assignments, options.DefiningItems), nil
+}
+
+// initialCenters picks k of the vectors as centers with k-means++: each
+// one after the first is picked with a probability proportional to its
+// squared distance to the nearest center already picked.
+func initialCenters(vectors [][]float64, k int, random *rand.Rand) [][]float64 {
+	picked := []int{random.Intn(len(vectors))}
+	isPicked := map[int]bool{picked[0]: true}
+
+	for len(picked) < k {
+		weights := make([]float64, len(vectors))
+		total := 0.0
+		for index, vector := range vectors {
+			if isPicked[index] {
+				continue
+			}
+
+			nearest := math.Inf(1)
+			for _, center := range picked {
+				nearest = math.Min(nearest, jaccardDistance(vector, vectors[center]))
+			}
+			weights[index] = nearest * nearest
+			total += weights[index]
+		}
+
+		next := -1
+		if total > 0 {
+			target := random.Float64() * total
+			for index, weight := range weights {
+				if weight == 0 {
+					continue
+				}
+				next = index
+				if target -= weight; target < 0 {
+					break
+				}
+			}
+		} else {
+			// Every strain left is the same as a center.
+			for index := range vectors {
+				if !isPicked[index] {
+					next = index
+					break
+				}
+			}
+		}
+
+		picked = append(picked, next)
+		isPicked[next] = true
+	}
+
+	centers := make([][]float64, k)
+	for index, vectorIndex := range picked {
+		centers[index] = append([]float64(nil), vectors[vectorIndex]...)
+	}
+	return centers
+}
+
+// nearestCenter returns the index of the center nearest to vector, the
+// lowest index on a tie.
+func nearestCenter(vector []float64, centers [][]float64) int {
+	nearest, nearestDistance := 0, math.Inf(1)
+	for index, center := range centers {
+		if distance := jaccardDistance(vector, center); distance < nearestDistance {
+			nearest, nearestDistance = index, distance
+		}
+	}
+	return nearest
+}
+
+// updateCenters moves each center to the mean of the vectors assigned to
+// it.  A center without any vectors stays where it is.
+func updateCenters(centers [][]float64, vectors [][]float64, assignments []int) {
+	sums := make([][]float64, len(centers))
+	counts := make([]int, len(centers))
+	for index, vector := range vectors {
+		cluster := assignments[index]
+		if sums[cluster] == nil {
+			sums[cluster] = make([]float64, len(vector))
+		}
+		for dimension, value := range vector {
+			sums[cluster][dimension] += value
+		}
+		counts[cluster]++
+	}
+
+	for cluster, sum := range sums {
+		if counts[cluster] == 0 {
+			continue
+		}
+		for dimension := range sum {
+			centers[cluster][dimension] = sum[dimension] / float64(counts[cluster])
+		}
+	}
+}
+
+type merge struct {
+	first    int
+	second   int
+	distance float64
+}
+
+// Hierarchical groups strains into options.K clusters by repeatedly
+// merging the two closest clusters, with the average Jaccard distance
+// between their strains' flavors and effects.  It builds the whole tree of
+// merges with the nearest-neighbor chain algorithm, in time quadratic in
+// the number of strains, and cuts it at K clusters.  The result is the
+// same every time.  Clusters are in order of their lowest strain ID.
+func Hierarchical(strains strainapiclient.ListAllStrainsResult, options ClusterOptions) ([]Cluster, error) {
+	if err := checkClusterCount(options.K, len(strains)); err != nil {
+		return nil, err
+	}
+
+	p := newProfiles(strains)
+	n := len(p.vectors)
+
+	distances := make([][]float64, n)
+	for i := range distances {
+		distances[i] = make([]float64, n)
+		for j := 0; j < i; j++ {
+			distances[i][j] = jaccardDistance(p.vectors[i], p.vectors[j])
+			distances[j][i] = distances[i][j]
+		}
+	}
+
+	sizes := make([]int, n)
+	active := make([]bool, n)
+	for index := range sizes {
+		sizes[index] = 1
+		active[index] = true
+	}
+
+	merges := make([]merge, 0, n-1)
+	chain := make([]int, 0, n)
+	for remaining := n; remaining > 1; {
+		if len(chain) == 0 {
+			for index := range active {
+				if active[index] {
+					chain = append(chain, index)
+					break
+				}
+			}
+		}
+
+		current := chain[len(chain)-1]
+		nearest, nearestDistance := -1, math.Inf(1)
+		if len(chain) > 1 {
+			// Preferring the previous link on a tie keeps the chain from
+			// cycling.
+			nearest = chain[len(chain)-2]
+			nearestDistance = distances[current][nearest]
+		}
+		for index := range active {
+			if active[index] && index != current && distances[current][index] < nearestDistance {
+				nearest, nearestDistance = index, distances[current][index]
+			}
+		}
+
+		if len(chain) < 2 || nearest != chain[len(chain)-2] {
+			chain = append(chain, nearest)
+			continue
+		}
+
+		chain = chain[:len(chain)-2]
+		kept, merged := current, nearest
+		if merged < kept {
+			kept, merged = merged, kept
+		}
+		merges = append(merges, merge{first: kept, second: merged, distance: nearestDistance})
+
+		// Average linkage, with the Lance-Williams update.
+		for index := range active {
+			if active[index] && index != kept && index != merged {
+				distance := (float64(sizes[kept])*distances[kept][index] + float64(sizes[merged])*distances[merged][index]) / float64(sizes[kept]+sizes[merged])
+				distances[kept][index] = distance
+				distances[index][kept] = distance
+			}
+		}
+		sizes[kept] += sizes[merged]
+		active[merged] = false
+		remaining--
+	}
+
+	sort.SliceStable(merges, func(i, j int) bool { return merges[i].distance < merges[j].distance })
+
+	parents := make([]int, n)
+	for index := range parents {
+		parents[index] = index
+	}
+	root := func(index int) int {
+		for parents[index] != index {
+			parents[index] = parents[parents[index]]
+			index = parents[index]
+		}
+		return index
+	}
+	for _, m := range merges[:n-options.K] {
+		parents[root(m.second)] = root(m.first)
+	}
+
+	assignments := make([]int, n)
+	for index := range assignments {
+		assignments[index] = root(index)
+	}
+	return p.clusters(assignments, options.DefiningItems), nil
+}
+
+// clusters groups the strains by their assignment, in order of their
+// lowest strain ID, and finds what defines each group.
+func (p profiles) clusters(assignments []int, maxDefiningItems int) []Cluster {
+	if maxDefiningItems <= 0 {
+		maxDefiningItems = DefaultDefiningItems
+	}
+
+	members := make(map[int][]int)
+	order := make([]int, 0)
+	for index, assignment := range assignments {
+		if _, found := members[assignment]; !found {
+			order = append(order, assignment)
+		}
+		members[assignment] = append(members[assignment], index)
+	}
+
+	overall := p.prevalence(nil)
+
+	clusters := make([]Cluster, 0, len(order))
+	for _, assignment := range order {
+		indexes := members[assignment]
+		cluster := Cluster{
+			StrainIDs: make([]int, len(indexes)),
+			Races:     make([]RaceCount, 0),
+			Flavors:   make([]strainapiclient.Flavor, 0),
+			Effects:   make(map[strainapiclient.EffectType][]string),
+		}
+
+		races := make(map[strainapiclient.Race]int)
+		for position, index := range indexes {
+			cluster.StrainIDs[position] = p.ids[index]
+			races[p.races[index]]++
+		}
+		for race, count := range races {
+			cluster.Races = append(cluster.Races, RaceCount{Race: race, Count: count})
+		}
+		sort.Slice(cluster.Races, func(i, j int) bool {
+			return byCount(cluster.Races[i].Count, cluster.Races[j].Count, string(cluster.Races[i].Race), string(cluster.Races[j].Race))
+		})
+
+		for _, item := range p.definingItems(indexes, overall) {
+			if item.IsFlavor() {
+				if len(cluster.Flavors) < maxDefiningItems {
+					cluster.Flavors = append(cluster.Flavors, item.Flavor)
+				}
+			} else if len(cluster.Effects[item.Type]) < maxDefiningItems {
+				cluster.Effects[item.Type] = append(cluster.Effects[item.Type], item.Effect)
+			}
+		}
+
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters
+}
+
+// prevalence returns the share of the strains at indexes, or of all
+// strains for nil, that have each item.
+func (p profiles) prevalence(indexes []int) []float64 {
+	if indexes == nil {
+		indexes = make([]int, len(p.vectors))
+		for index := range indexes {
+			indexes[index] = index
+		}
+	}
+
+	shares := make([]float64, len(p.items))
+	for _, index := range indexes {
+		for dimension, value := range p.vectors[index] {
+			shares[dimension] += value
+		}
+	}
+	for dimension := range shares {
+		shares[dimension] /= float64(len(indexes))
+	}
+	return shares
+}
+
+// definingItems returns the items at least half of the strains at indexes
+// have and that are at least as common among them as among all strains,
+// ranked by how much more common they are, and then by how common.
+func (p profiles) definingItems(indexes []int, overall []float64) []Item {
+	shares := p.prevalence(indexes)
+
+	dimensions := make([]int, 0)
+	for dimension, share := range shares {
+		if share >= 0.5 && share >= overall[dimension] {
+			dimensions = append(dimensions, dimension)
+		}
+	}
+
+	sort.SliceStable(dimensions, func(i, j int) bool {
+		first, second := dimensions[i], dimensions[j]
+		firstLift, secondLift := shares[first]/overall[first], shares[second]/overall[second]
+		if firstLift != secondLift {
+			return firstLift > secondLift
+		}
+		return shares[first] > shares[second]
+	})
+
+	items := make([]Item, len(dimensions))
+	for index, dimension := range dimensions {
+		items[index] = p.items[dimension]
+	}
+	return items
+}
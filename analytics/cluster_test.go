@@ -0,0 +1,143 @@
+package analytics
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tchype/strainapiclient-go"
+)
+
+func clusterStrainIDs(clusters []Cluster) [][]int {
+	ids := make([][]int, len(clusters))
+	for index, cluster := range clusters {
+		ids[index] = cluster.StrainIDs
+	}
+	return ids
+}
+
+// randomStrains returns strains with flavors and effects picked at random
+// from a few profiles.
+func randomStrains(count int) strainapiclient.ListAllStrainsResult {
+	profiles := [][]string{
+		{"Citrus", "Lemon", "Sweet", "Energetic", "Creative", "Euphoric"},
+		{"Earthy", "Pine", "Woody", "Relaxed", "Sleepy", "Hungry"},
+		{"Berry", "Sweet", "Grape", "Happy", "Relaxed", "Uplifted"},
+	}
+
+	random := rand.New(rand.NewSource(1))
+	strains := make(strainapiclient.ListAllStrainsResult, count)
+	for id := 1; id <= count; id++ {
+		profile := profiles[random.Intn(len(profiles))]
+		strain := strainapiclient.Strain{ID: id, Name: fmt.Sprintf("Strain %d", id), Race: strainapiclient.RaceHybrid}
+		strain.Effects = map[strainapiclient.EffectType][]string{}
+		for index, term := range profile {
+			if random.Float64() < 0.2 {
+				continue
+			}
+			if index < 3 {
+				strain.Flavors = append(strain.Flavors, strainapiclient.Flavor(term))
+			} else {
+				strain.Effects[strainapiclient.EffectTypePositive] = append(strain.Effects[strainapiclient.EffectTypePositive], term)
+			}
+		}
+		strains[strain.Name] = strain
+	}
+	return strains
+}
+
+func TestKMeans(t *testing.T) {
+	clusters, err := KMeans(knownStrains(t), ClusterOptions{K: 2, Seed: 42})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([][]int{{1, 2, 4}, {3, 5}}, clusterStrainIDs(clusters)); diff != "" {
+		t.Errorf("Unexpected clusters (-expected +got):\n%s", diff)
+	}
+
+	sativas := clusters[1]
+	if diff := cmp.Diff([]RaceCount{{Race: strainapiclient.RaceSativa, Count: 2}}, sativas.Races); diff != "" {
+		t.Errorf("Unexpected races (-expected +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]strainapiclient.Flavor{"Citrus", "Diesel", "Lemon"}, sativas.Flavors); diff != "" {
+		t.Errorf("Unexpected defining flavors (-expected +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"Creative", "Energetic"}, sativas.Effects[strainapiclient.EffectTypePositive][:2]); diff != "" {
+		t.Errorf("Unexpected defining effects (-expected +got):\n%s", diff)
+	}
+}
+
+func TestKMeansIsDeterministic(t *testing.T) {
+	strains := randomStrains(200)
+
+	for seed := int64(0); seed < 5; seed++ {
+		first, err := KMeans(strains, ClusterOptions{K: 3, Seed: seed})
+		if err != nil {
+			t.Fatal(err)
+		}
+		second, err := KMeans(strains, ClusterOptions{K: 3, Seed: seed})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(first, second); diff != "" {
+			t.Errorf("Seed %d: expected the same clusters (-first +second):\n%s", seed, diff)
+		}
+
+		members := 0
+		for _, cluster := range first {
+			members += len(cluster.StrainIDs)
+		}
+		if members != 200 {
+			t.Errorf("Seed %d: expected every strain in a cluster, got %d", seed, members)
+		}
+	}
+}
+
+func TestHierarchical(t *testing.T) {
+	strains := knownStrains(t)
+
+	tests := []struct {
+		k        int
+		expected [][]int
+	}{
+		{1, [][]int{{1, 2, 3, 4, 5}}},
+		{2, [][]int{{1, 2, 4}, {3, 5}}},
+		{3, [][]int{{1, 2}, {3, 5}, {4}}},
+		{5, [][]int{{1}, {2}, {3}, {4}, {5}}},
+	}
+
+	for _, test := range tests {
+		clusters, err := Hierarchical(strains, ClusterOptions{K: test.k})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(test.expected, clusterStrainIDs(clusters)); diff != "" {
+			t.Errorf("K %d: unexpected clusters (-expected +got):\n%s", test.k, diff)
+		}
+	}
+
+	clusters, err := Hierarchical(randomStrains(300), ClusterOptions{K: 3, DefiningItems: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cluster := range clusters {
+		if len(cluster.Flavors) != 1 {
+			t.Errorf("Expected one defining flavor per cluster, got %v", cluster)
+		}
+	}
+}
+
+func TestInvalidClusterCount(t *testing.T) {
+	for _, k := range []int{0, 6} {
+		if _, err := KMeans(knownStrains(t), ClusterOptions{K: k}); !errors.Is(err, ErrInvalidClusterCount) {
+			t.Errorf("KMeans K %d: expected ErrInvalidClusterCount, got %v", k, err)
+		}
+		if _, err := Hierarchical(knownStrains(t), ClusterOptions{K: k}); !errors.Is(err, ErrInvalidClusterCount) {
+			t.Errorf("Hierarchical K %d: expected ErrInvalidClusterCount, got %v", k, err)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package analytics
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+// The aggregates in the first column of WriteCSV.
+const (
+	AggregateStrains                       = "strains"
+	AggregateRace                          = "race"
+	AggregateFlavor                        = "flavor"
+	AggregateEffect                        = "effect"
+	AggregateCoOccurrence                  = "co_occurrence"
+	AggregateAverageFlavorsPerStrain       = "average_flavors_per_strain"
+	AggregateAverageEffectsPerStrain       = "average_effects_per_strain"
+	AggregateAverageEffectsPerStrainByType = "average_effects_per_strain_by_type"
+)
+
+// CSVHeader is the first row written by WriteCSV.
+var CSVHeader = []string{"aggregate", "key", "subkey", "value"}
+
+// Rows returns every aggregate as a row of CSVHeader, in one long table
+// that is easy to filter by aggregate in a spreadsheet or dashboard:
+//
+//	race,indica,,2
+//	effect,positive,Happy,5
+//	co_occurrence,Earthy,positive/Happy,3
+//
+// The subkey is empty for aggregates that only have a key.
+func (s Stats) Rows() [][]string {
+	rows := [][]string{{AggregateStrains, "", "", strconv.Itoa(s.Strains)}}
+
+	for _, race := range s.Races {
+		rows = append(rows, []string{AggregateRace, string(race.Race), "", strconv.Itoa(race.Count)})
+	}
+	for _, flavor := range s.Flavors {
+		rows = append(rows, []string{AggregateFlavor, string(flavor.Flavor), "", strconv.Itoa(flavor.Count)})
+	}
+	for _, effectType := range s.effectTypes() {
+		for _, effect := range s.Effects[effectType] {
+			rows = append(rows, []string{AggregateEffect, string(effectType), effect.Effect, strconv.Itoa(effect.Count)})
+		}
+	}
+	for _, coOccurrence := range s.CoOccurrences {
+		effect := fmt.Sprintf("%s/%s", coOccurrence.Type, coOccurrence.Effect)
+		rows = append(rows, []string{AggregateCoOccurrence, string(coOccurrence.Flavor), effect, strconv.Itoa(coOccurrence.Count)})
+	}
+
+	rows = append(rows,
+		[]string{AggregateAverageFlavorsPerStrain, "", "", formatAverage(s.AverageFlavorsPerStrain)},
+		[]string{AggregateAverageEffectsPerStrain, "", "", formatAverage(s.AverageEffectsPerStrain)},
+	)
+	for _, effectType := range s.effectTypes() {
+		rows = append(rows, []string{AggregateAverageEffectsPerStrainByType, string(effectType), "", formatAverage(s.AverageEffectsPerStrainByType[effectType])})
+	}
+
+	return rows
+}
+
+// effectTypes returns the EffectTypes in the Stats, the well-known ones
+// first.
+func (s Stats) effectTypes() []strainapiclient.EffectType {
+	effectTypes := make([]strainapiclient.EffectType, 0, len(s.Effects))
+	for _, effectType := range strainapiclient.AllEffectTypes() {
+		if _, found := s.Effects[effectType]; found {
+			effectTypes = append(effectTypes, effectType)
+		}
+	}
+
+	others := make([]strainapiclient.EffectType, 0)
+	for effectType := range s.Effects {
+		if !effectType.IsValid() {
+			others = append(others, effectType)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool { return others[i] < others[j] })
+
+	return append(effectTypes, others...)
+}
+
+func formatAverage(average float64) string {
+	return strconv.FormatFloat(average, 'f', 2, 64)
+}
+
+// WriteCSV writes CSVHeader followed by Rows.
+func (s Stats) WriteCSV(w io.Writer) error {
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(CSVHeader); err != nil {
+		return err
+	}
+	if err := csvWriter.WriteAll(s.Rows()); err != nil {
+		return err
+	}
+	return csvWriter.Error()
+}
+
+// WriteJSON writes the Stats as indented JSON.
+func (s Stats) WriteJSON(w io.Writer) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
@@ -0,0 +1,251 @@
+package analytics
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+// Item is a flavor or an effect in an association Rule.  Exactly one of
+// Flavor and Effect is set.
+type Item struct {
+	Flavor strainapiclient.Flavor     `json:"flavor,omitempty"`
+	Type   strainapiclient.EffectType `json:"type,omitempty"`
+	Effect string                     `json:"effect,omitempty"`
+}
+
+// FlavorItem returns the Item for a flavor.
+func FlavorItem(flavor strainapiclient.Flavor) Item {
+	return Item{Flavor: flavor}
+}
+
+// EffectItem returns the Item for an effect.
+func EffectItem(effectType strainapiclient.EffectType, effect string) Item {
+	return Item{Type: effectType, Effect: effect}
+}
+
+// IsFlavor reports whether the Item is a flavor rather than an effect.
+func (i Item) IsFlavor() bool {
+	return i.Flavor != ""
+}
+
+// String returns the flavor, or the effect as "positive/Happy".
+func (i Item) String() string {
+	if i.IsFlavor() {
+		return string(i.Flavor)
+	}
+	return fmt.Sprintf("%s/%s", i.Type, i.Effect)
+}
+
+// Rule says that strains with the Antecedent tend to have the Consequent.
+//
+// Support is the share of all strains that have both, Confidence the share
+// of strains with the Antecedent that also have the Consequent, and Lift
+// is how many times more likely the Consequent is with the Antecedent than
+// without knowing anything; a Lift above 1 means the Antecedent predicts
+// the Consequent.  Count is the number of strains that have both.
+type Rule struct {
+	Antecedent Item    `json:"antecedent"`
+	Consequent Item    `json:"consequent"`
+	Count      int     `json:"count"`
+	Support    float64 `json:"support"`
+	Confidence float64 `json:"confidence"`
+	Lift       float64 `json:"lift"`
+}
+
+func (r Rule) String() string {
+	return fmt.Sprintf("%s -> %s (support %.2f, confidence %.2f, lift %.2f)", r.Antecedent, r.Consequent, r.Support, r.Confidence, r.Lift)
+}
+
+// RuleOptions are the thresholds a Rule must meet to be returned.  Zero
+// values let every rule through.
+type RuleOptions struct {
+	MinSupport    float64
+	MinConfidence float64
+	MinLift       float64
+
+	// MinCount is the number of strains that must have both items, which
+	// keeps rules seen in a single strain out of small catalogs.
+	MinCount int
+
+	// Limit is the most rules returned, after ranking; 0 is no limit.
+	Limit int
+}
+
+// Rules are association rules, ranked by Lift, then Confidence, then
+// Support, from the highest.
+type Rules []Rule
+
+// FlavorEffectRules returns the rules from each flavor to each effect
+// found with it that meet the options.
+func FlavorEffectRules(strains strainapiclient.ListAllStrainsResult, options RuleOptions) Rules {
+	return mineRules(strains, options, true)
+}
+
+// EffectEffectRules returns the rules from each effect to each other
+// effect found with it that meet the options.
+func EffectEffectRules(strains strainapiclient.ListAllStrainsResult, options RuleOptions) Rules {
+	return mineRules(strains, options, false)
+}
+
+// AssociationRules returns both the FlavorEffectRules and the
+// EffectEffectRules, ranked together.
+func AssociationRules(strains strainapiclient.ListAllStrainsResult, options RuleOptions) Rules {
+	limit := options.Limit
+	options.Limit = 0
+
+	rules := append(FlavorEffectRules(strains, options), EffectEffectRules(strains, options)...)
+	rules.rank()
+	return rules.limit(limit)
+}
+
+type itemPair struct {
+	antecedent Item
+	consequent Item
+}
+
+func mineRules(strains strainapiclient.ListAllStrainsResult, options RuleOptions, fromFlavors bool) Rules {
+	itemCounts := make(map[Item]int)
+	pairCounts := make(map[itemPair]int)
+
+	for _, strain := range strains {
+		normalized, _ := strainapiclient.NormalizeStrain(strain)
+
+		flavors := make([]Item, 0, len(normalized.Flavors))
+		for _, flavor := range normalized.Flavors {
+			flavors = append(flavors, FlavorItem(flavor))
+		}
+		effects := make([]Item, 0)
+		for effectType, names := range normalized.Effects {
+			for _, name := range names {
+				effects = append(effects, EffectItem(effectType, name))
+			}
+		}
+
+		antecedents := effects
+		if fromFlavors {
+			antecedents = flavors
+		}
+
+		for _, item := range antecedents {
+			itemCounts[item]++
+		}
+		for _, effect := range effects {
+			if fromFlavors {
+				itemCounts[effect]++
+			}
+			for _, antecedent := range antecedents {
+				if antecedent != effect {
+					pairCounts[itemPair{antecedent: antecedent, consequent: effect}]++
+				}
+			}
+		}
+	}
+
+	rules := make(Rules, 0)
+	if len(strains) == 0 {
+		return rules
+	}
+	total := float64(len(strains))
+
+	for pair, count := range pairCounts {
+		rule := Rule{
+			Antecedent: pair.antecedent,
+			Consequent: pair.consequent,
+			Count:      count,
+			Support:    float64(count) / total,
+			Confidence: float64(count) / float64(itemCounts[pair.antecedent]),
+		}
+		rule.Lift = rule.Confidence / (float64(itemCounts[pair.consequent]) / total)
+
+		if count >= options.MinCount && rule.Support >= options.MinSupport && rule.Confidence >= options.MinConfidence && rule.Lift >= options.MinLift {
+			rules = append(rules, rule)
+		}
+	}
+
+	rules.rank()
+	return rules.limit(options.Limit)
+}
+
+func (r Rules) rank() {
+	sort.Slice(r, func(i, j int) bool {
+		first, second := r[i], r[j]
+		switch {
+		case first.Lift != second.Lift:
+			return first.Lift > second.Lift
+		case first.Confidence != second.Confidence:
+			return first.Confidence > second.Confidence
+		case first.Support != second.Support:
+			return first.Support > second.Support
+		case first.Antecedent != second.Antecedent:
+			return first.Antecedent.String() < second.Antecedent.String()
+		}
+		return first.Consequent.String() < second.Consequent.String()
+	})
+}
+
+func (r Rules) limit(limit int) Rules {
+	if limit > 0 && limit < len(r) {
+		return r[:limit]
+	}
+	return r
+}
+
+// From returns the rules with antecedent as their Antecedent, in the same
+// order, such as the effects to recommend for a flavor.
+func (r Rules) From(antecedent Item) Rules {
+	found := make(Rules, 0)
+	for _, rule := range r {
+		if rule.Antecedent == antecedent {
+			found = append(found, rule)
+		}
+	}
+	return found
+}
+
+// RulesCSVHeader is the first row written by Rules.WriteCSV.
+var RulesCSVHeader = []string{"antecedent", "consequent", "count", "support", "confidence", "lift"}
+
+// Rows returns each rule as a row of RulesCSVHeader.
+func (r Rules) Rows() [][]string {
+	rows := make([][]string, len(r))
+	for index, rule := range r {
+		rows[index] = []string{
+			rule.Antecedent.String(),
+			rule.Consequent.String(),
+			strconv.Itoa(rule.Count),
+			strconv.FormatFloat(rule.Support, 'f', 4, 64),
+			strconv.FormatFloat(rule.Confidence, 'f', 4, 64),
+			strconv.FormatFloat(rule.Lift, 'f', 4, 64),
+		}
+	}
+	return rows
+}
+
+// WriteCSV writes RulesCSVHeader followed by Rows.
+func (r Rules) WriteCSV(w io.Writer) error {
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(RulesCSVHeader); err != nil {
+		return err
+	}
+	if err := csvWriter.WriteAll(r.Rows()); err != nil {
+		return err
+	}
+	return csvWriter.Error()
+}
+
+// WriteJSON writes the rules as indented JSON.
+func (r Rules) WriteJSON(w io.Writer) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
@@ -0,0 +1,84 @@
+package analytics
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+func knownStrains(t *testing.T) strainapiclient.ListAllStrainsResult {
+	strains, err := clienttest.NewFixtureClient().ListAllStrains()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strains
+}
+
+func TestFlavorEffectRules(t *testing.T) {
+	rules := FlavorEffectRules(knownStrains(t), RuleOptions{MinCount: 2, MinLift: 2})
+
+	citrus := FlavorItem("Citrus")
+	expected := Rules{
+		{Antecedent: citrus, Consequent: EffectItem(strainapiclient.EffectTypeMedical, "Fatigue"), Count: 2, Support: 0.4, Confidence: 1, Lift: 2.5},
+		{Antecedent: citrus, Consequent: EffectItem(strainapiclient.EffectTypeNegative, "Paranoid"), Count: 2, Support: 0.4, Confidence: 1, Lift: 2.5},
+		{Antecedent: citrus, Consequent: EffectItem(strainapiclient.EffectTypePositive, "Creative"), Count: 2, Support: 0.4, Confidence: 1, Lift: 2.5},
+		{Antecedent: citrus, Consequent: EffectItem(strainapiclient.EffectTypePositive, "Energetic"), Count: 2, Support: 0.4, Confidence: 1, Lift: 2.5},
+		{Antecedent: FlavorItem("Pine"), Consequent: EffectItem(strainapiclient.EffectTypeNegative, "Dizzy"), Count: 2, Support: 0.4, Confidence: 1, Lift: 2.5},
+		{Antecedent: FlavorItem("Sweet"), Consequent: EffectItem(strainapiclient.EffectTypePositive, "Euphoric"), Count: 2, Support: 0.4, Confidence: 1, Lift: 2.5},
+	}
+	if diff := cmp.Diff(expected, rules); diff != "" {
+		t.Errorf("Unexpected rules (-expected +got):\n%s", diff)
+	}
+
+	earthy := FlavorEffectRules(knownStrains(t), RuleOptions{}).From(FlavorItem("Earthy"))
+	if len(earthy) == 0 || earthy[0].Consequent != EffectItem(strainapiclient.EffectTypeNegative, "Dizzy") || earthy[0].Count != 2 {
+		t.Errorf("Expected Dizzy to be the effect best predicted by Earthy, got %v", earthy)
+	}
+
+	if rules := FlavorEffectRules(knownStrains(t), RuleOptions{MinConfidence: 0.5, Limit: 3}); len(rules) != 3 {
+		t.Errorf("Expected the limit to apply, got %d rules", len(rules))
+	}
+}
+
+func TestEffectEffectRules(t *testing.T) {
+	rules := EffectEffectRules(knownStrains(t), RuleOptions{MinSupport: 0.4})
+
+	for _, rule := range rules {
+		if rule.Antecedent.IsFlavor() || rule.Consequent.IsFlavor() || rule.Antecedent == rule.Consequent || rule.Support < 0.4 {
+			t.Errorf("Unexpected rule %v", rule)
+		}
+	}
+
+	energetic := rules.From(EffectItem(strainapiclient.EffectTypePositive, "Energetic"))
+	if len(energetic) == 0 || energetic[0].Lift != 2.5 || energetic[0].Confidence != 1 {
+		t.Errorf("Expected Energetic to predict effects only found with it, got %v", energetic)
+	}
+
+	if rules := AssociationRules(strainapiclient.ListAllStrainsResult{}, RuleOptions{}); len(rules) != 0 {
+		t.Errorf("Expected no rules without strains, got %v", rules)
+	}
+}
+
+func TestRulesWriteCSV(t *testing.T) {
+	rules := AssociationRules(knownStrains(t), RuleOptions{MinCount: 2, MinLift: 2, Limit: 2})
+
+	var buffer bytes.Buffer
+	if err := rules.WriteCSV(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(&buffer).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("Expected a header and 2 rules, got %v", records)
+	}
+	if diff := cmp.Diff([]string{"Citrus", "medical/Fatigue", "2", "0.4000", "1.0000", "2.5000"}, records[1]); diff != "" {
+		t.Errorf("Unexpected row (-expected +got):\n%s", diff)
+	}
+}
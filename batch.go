@@ -0,0 +1,185 @@
+package strainapiclient
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultBatchConcurrency is how many requests a batch makes at once when
+// BatchOptions.Concurrency is not set.
+const DefaultBatchConcurrency int = 8
+
+// BatchOptions configures the BatchGetStrain functions.
+type BatchOptions struct {
+	// Concurrency is how many requests are made at once.  It is
+	// DefaultBatchConcurrency if zero.
+	Concurrency int
+	// RateLimiter, if set, holds back every request of the batch.  There is
+	// no need to set it when the client already has a RateLimitMiddleware.
+	RateLimiter *RateLimiter
+}
+
+// BatchError is returned by the BatchGetStrain functions when the requests
+// for some of the IDs failed.  The results for the other IDs are still
+// returned.
+type BatchError struct {
+	// Errors holds the error for each ID whose request failed.
+	Errors map[int]error
+}
+
+func (e *BatchError) Error() string {
+	ids := e.IDs()
+
+	messages := make([]string, len(ids))
+	for index, id := range ids {
+		messages[index] = fmt.Sprintf("ID %d: %s", id, e.Errors[id])
+	}
+
+	return fmt.Sprintf("%d requests failed: %s", len(ids), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the error of each ID, in the order of IDs, which errors.Is
+// and errors.As follow from Go 1.20.
+func (e *BatchError) Unwrap() []error {
+	ids := e.IDs()
+
+	errs := make([]error, len(ids))
+	for index, id := range ids {
+		errs[index] = e.Errors[id]
+	}
+	return errs
+}
+
+// Is reports whether the error of any ID is target, so errors.Is finds it
+// before Go 1.20 too.
+func (e *BatchError) Is(target error) bool {
+	for _, err := range e.Unwrap() {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first error of an ID, in the order of IDs, that matches
+// target, so errors.As finds it before Go 1.20 too.
+func (e *BatchError) As(target interface{}) bool {
+	for _, err := range e.Unwrap() {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// IDs returns the IDs whose requests failed, in ascending order.
+func (e *BatchError) IDs() []int {
+	ids := make([]int, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// BatchGetStrainDescriptions gets the description of each strain in ids,
+// keyed by ID.  See batchGet for how the requests are made.
+func BatchGetStrainDescriptions(client Client, ids []int, opts BatchOptions) (map[int]string, error) {
+	descriptions := make(map[int]string, len(ids))
+
+	err := batchGet(ids, opts, endpointGetStrainDescriptionByStrainID, func(id int) (interface{}, error) {
+		return client.GetStrainDescriptionByStrainID(id)
+	}, func(id int, value interface{}) {
+		descriptions[id] = value.(string)
+	})
+
+	return descriptions, err
+}
+
+// BatchGetStrainFlavors gets the flavors of each strain in ids, keyed by ID.
+// See batchGet for how the requests are made.
+func BatchGetStrainFlavors(client Client, ids []int, opts BatchOptions) (map[int][]Flavor, error) {
+	flavors := make(map[int][]Flavor, len(ids))
+
+	err := batchGet(ids, opts, endpointGetStrainFlavorsByStrainID, func(id int) (interface{}, error) {
+		return client.GetStrainFlavorsByStrainID(id)
+	}, func(id int, value interface{}) {
+		flavors[id] = value.([]Flavor)
+	})
+
+	return flavors, err
+}
+
+// BatchGetStrainEffects gets the effects of each strain in ids, keyed by
+// ID.  See batchGet for how the requests are made.
+func BatchGetStrainEffects(client Client, ids []int, opts BatchOptions) (map[int]EffectsByEffectType, error) {
+	effects := make(map[int]EffectsByEffectType, len(ids))
+
+	err := batchGet(ids, opts, endpointGetStrainEffectsByStrainID, func(id int) (interface{}, error) {
+		return client.GetStrainEffectsByStrainID(id)
+	}, func(id int, value interface{}) {
+		effects[id] = value.(EffectsByEffectType)
+	})
+
+	return effects, err
+}
+
+// batchGet calls fetch for each distinct ID in ids from a pool of
+// opts.Concurrency workers, passing every result to store, one at a time.
+// Rather than stopping at the first failure, it carries on with the other
+// IDs and returns a *BatchError with the error of each ID that failed.
+func batchGet(ids []int, opts BatchOptions, endpoint string, fetch func(id int) (interface{}, error), store func(id int, value interface{})) error {
+	workers := opts.Concurrency
+	if workers <= 0 {
+		workers = DefaultBatchConcurrency
+	}
+	if len(ids) < workers {
+		workers = len(ids)
+	}
+
+	errs := make(map[int]error)
+	var mutex sync.Mutex
+
+	work := make(chan int)
+	var wg sync.WaitGroup
+
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range work {
+				if opts.RateLimiter != nil {
+					opts.RateLimiter.wait(endpoint)
+				}
+
+				value, err := fetch(id)
+
+				mutex.Lock()
+				if err != nil {
+					errs[id] = err
+				} else {
+					store(id, value)
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	seen := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			work <- id
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return &BatchError{Errors: errs}
+	}
+	return nil
+}
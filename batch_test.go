@@ -0,0 +1,111 @@
+package strainapiclient_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+func TestBatchGetStrainEffects(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+
+	var mutex sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	client.Use(
+		func(next strainapiclient.HandleResourceRequestFunc) strainapiclient.HandleResourceRequestFunc {
+			return func(resourcePath string) ([]byte, error) {
+				mutex.Lock()
+				inFlight++
+				if inFlight > maxInFlight {
+					maxInFlight = inFlight
+				}
+				mutex.Unlock()
+
+				time.Sleep(5 * time.Millisecond)
+
+				mutex.Lock()
+				inFlight--
+				mutex.Unlock()
+				return next(resourcePath)
+			}
+		},
+		strainapiclient.StubMiddleware(strainapiclient.Stub{Path: "/strains/data/effects/4", Err: errors.New("stubbed")}),
+	)
+
+	effects, err := strainapiclient.BatchGetStrainEffects(client, []int{1, 2, 3, 4, 5, 1}, strainapiclient.BatchOptions{Concurrency: 2})
+
+	var batchErr *strainapiclient.BatchError
+	if !errors.As(err, &batchErr) || !reflect.DeepEqual(batchErr.IDs(), []int{4}) {
+		t.Fatalf("Expected a BatchError for ID 4 only, got %v", err)
+	}
+
+	if len(effects) != 4 {
+		t.Errorf("Expected the effects of the other 4 strains, got %v", effects)
+	}
+	for _, id := range []int{1, 2, 3, 5} {
+		if len(effects[id][strainapiclient.EffectTypePositive]) == 0 {
+			t.Errorf("Expected positive effects for strain %d, got %v", id, effects[id])
+		}
+	}
+
+	if maxInFlight != 2 {
+		t.Errorf("Expected 2 requests at once, got %d", maxInFlight)
+	}
+}
+
+func TestBatchGetStrainDescriptionsAndFlavors(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+	dataset := clienttest.KnownDataset()
+
+	descriptions, err := strainapiclient.BatchGetStrainDescriptions(client, []int{1, 3, clienttest.UnknownStrainID}, strainapiclient.BatchOptions{})
+	if err == nil || !errors.Is(err.(*strainapiclient.BatchError).Errors[clienttest.UnknownStrainID], strainapiclient.ErrDescriptionNotFound) {
+		t.Errorf("Expected ErrDescriptionNotFound for the unknown ID, got %v", err)
+	}
+	for _, id := range []int{1, 3} {
+		expected, _ := dataset.StrainByID(id)
+		if descriptions[id] != expected.Description {
+			t.Errorf("Expected the description of strain %d, got %q", id, descriptions[id])
+		}
+	}
+
+	limiter := strainapiclient.NewRateLimiter(1000, 1)
+	flavors, err := strainapiclient.BatchGetStrainFlavors(client, []int{2, 5}, strainapiclient.BatchOptions{RateLimiter: limiter})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []int{2, 5} {
+		expected, _ := dataset.StrainByID(id)
+		if !reflect.DeepEqual(flavors[id], expected.Flavors) {
+			t.Errorf("Expected the flavors of strain %d, got %v", id, flavors[id])
+		}
+	}
+}
+
+func TestBatchErrorIsAndAs(t *testing.T) {
+	batchErr := &strainapiclient.BatchError{Errors: map[int]error{
+		2: &strainapiclient.StatusError{StatusCode: http.StatusTooManyRequests},
+		7: fmt.Errorf("strain 7: %w", strainapiclient.ErrDescriptionNotFound),
+	}}
+
+	// Called directly, as errors.Is and errors.As only follow Unwrap() []error
+	// from Go 1.20.
+	if !batchErr.Is(strainapiclient.ErrDescriptionNotFound) || batchErr.Is(strainapiclient.ErrStrainNotFound) {
+		t.Error("Expected Is to match the error of an ID and nothing else")
+	}
+
+	var statusErr *strainapiclient.StatusError
+	if !batchErr.As(&statusErr) || statusErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("Expected As to find the *StatusError, got %v", statusErr)
+	}
+	var connectionErr *strainapiclient.ConnectionError
+	if batchErr.As(&connectionErr) {
+		t.Error("Expected As not to find a *ConnectionError")
+	}
+}
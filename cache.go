@@ -0,0 +1,271 @@
+package strainapiclient
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"time"
+)
+
+// errNotCached is returned by ResponseCache.Stale when there is no response
+// for the resource path.
+var errNotCached = errors.New("No cached response for the resource path")
+
+// DefaultMaxStale is how long a ResponseCache keeps a response after it
+// expires, for Stale, unless SetMaxStale is called.
+const DefaultMaxStale = 24 * time.Hour
+
+// ResponseCache keeps the responses of a HandleResourceRequestFunc for a
+// fixed amount of time so repeated requests for the same resource do not
+// call the API again.  It is safe for concurrent use.
+type ResponseCache struct {
+	ttl        time.Duration
+	maxStale   time.Duration
+	maxEntries int
+	mutex      sync.Mutex
+	entries    map[string]*list.Element
+	// recent holds a *responseCacheEntry for each entry, from the most
+	// recently used to the least
+	recent    *list.List
+	nextSweep time.Time
+	now       func() time.Time
+
+	collector MetricsCollector
+}
+
+type responseCacheEntry struct {
+	resourcePath string
+	body         []byte
+	expires      time.Time
+}
+
+// NewResponseCache creates a ResponseCache whose entries expire after ttl.
+func NewResponseCache(ttl time.Duration) *ResponseCache {
+	return &ResponseCache{
+		ttl:      ttl,
+		maxStale: DefaultMaxStale,
+		entries:  make(map[string]*list.Element),
+		recent:   list.New(),
+		now:      time.Now,
+	}
+}
+
+// SetMaxStale sets how long responses are kept after they expire, so that
+// Stale can still return them, and returns the previous value.  Responses
+// older than that are removed.  0 removes responses as soon as they expire.
+func (rc *ResponseCache) SetMaxStale(maxStale time.Duration) time.Duration {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	current := rc.maxStale
+	rc.maxStale = maxStale
+	return current
+}
+
+// SetMaxEntries sets the most responses kept, removing the least recently
+// used ones beyond it, and returns the previous value.  0, the default,
+// is no limit; set one when the resource paths come from untrusted
+// clients, as each search term is a new entry.
+func (rc *ResponseCache) SetMaxEntries(maxEntries int) int {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	current := rc.maxEntries
+	rc.maxEntries = maxEntries
+	rc.evictLeastRecentlyUsed()
+	return current
+}
+
+// evictLeastRecentlyUsed removes entries until there are no more than
+// maxEntries.  The mutex must be held.
+func (rc *ResponseCache) evictLeastRecentlyUsed() {
+	for rc.maxEntries > 0 && rc.recent.Len() > rc.maxEntries {
+		rc.remove(rc.recent.Back())
+	}
+}
+
+// remove removes the entry in element.  The mutex must be held.
+func (rc *ResponseCache) remove(element *list.Element) {
+	rc.recent.Remove(element)
+	delete(rc.entries, element.Value.(*responseCacheEntry).resourcePath)
+}
+
+// isTooStale reports whether entry has been expired for longer than
+// maxStale.  The mutex must be held.
+func (rc *ResponseCache) isTooStale(entry *responseCacheEntry, now time.Time) bool {
+	return !now.Before(entry.expires.Add(rc.maxStale))
+}
+
+// Get returns the cached response for resourcePath if there is one
+// that has not expired.
+func (rc *ResponseCache) Get(resourcePath string) ([]byte, bool) {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	element, found := rc.entries[resourcePath]
+	if !found {
+		return nil, false
+	}
+	entry := element.Value.(*responseCacheEntry)
+
+	now := rc.now()
+	if rc.isTooStale(entry, now) {
+		rc.remove(element)
+		return nil, false
+	}
+	if !now.Before(entry.expires) {
+		return nil, false
+	}
+
+	rc.recent.MoveToFront(element)
+	return entry.body, true
+}
+
+// Stale returns the cached response for resourcePath even if it has
+// expired, as long as it has not been expired for longer than the max
+// stale age (see SetMaxStale), failing if there is none.  This lets a
+// CircuitBreaker fall back on it while the API is down.
+func (rc *ResponseCache) Stale(resourcePath string) ([]byte, error) {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	element, found := rc.entries[resourcePath]
+	if !found {
+		return make([]byte, 0), errNotCached
+	}
+
+	entry := element.Value.(*responseCacheEntry)
+	if rc.isTooStale(entry, rc.now()) {
+		rc.remove(element)
+		return make([]byte, 0), errNotCached
+	}
+
+	return entry.body, nil
+}
+
+// Set caches the response for resourcePath, also removing the responses
+// that have been expired for longer than the max stale age and the least
+// recently used ones beyond the max entries.
+func (rc *ResponseCache) Set(resourcePath string, body []byte) {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	// Sweeping at most once per ttl keeps Set cheap while still bounding
+	// the expired responses kept.
+	now := rc.now()
+	if !now.Before(rc.nextSweep) {
+		rc.sweep(now)
+		rc.nextSweep = now.Add(rc.ttl)
+	}
+
+	entry := &responseCacheEntry{resourcePath: resourcePath, body: body, expires: now.Add(rc.ttl)}
+	if element, found := rc.entries[resourcePath]; found {
+		element.Value = entry
+		rc.recent.MoveToFront(element)
+		return
+	}
+
+	rc.entries[resourcePath] = rc.recent.PushFront(entry)
+	rc.evictLeastRecentlyUsed()
+}
+
+// Sweep removes every response that has been expired for longer than the
+// max stale age.
+func (rc *ResponseCache) Sweep() {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	rc.sweep(rc.now())
+}
+
+// sweep is Sweep with the mutex held.
+func (rc *ResponseCache) sweep(now time.Time) {
+	for element := rc.recent.Front(); element != nil; {
+		next := element.Next()
+		if rc.isTooStale(element.Value.(*responseCacheEntry), now) {
+			rc.remove(element)
+		}
+		element = next
+	}
+}
+
+// SweepEvery calls Sweep every interval from a new goroutine, so expired
+// responses are removed even when nothing new is cached, until the
+// returned function is called.
+func (rc *ResponseCache) SweepEvery(interval time.Duration) (stop func()) {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				rc.Sweep()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			ticker.Stop()
+			close(done)
+		})
+	}
+}
+
+// SetMetricsCollector sets the MetricsCollector the hits and misses of Wrap
+// are reported to and returns the previous value.
+func (rc *ResponseCache) SetMetricsCollector(collector MetricsCollector) MetricsCollector {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	current := rc.collector
+	rc.collector = collector
+	return current
+}
+
+func (rc *ResponseCache) metricsCollector() MetricsCollector {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	return rc.collector
+}
+
+// Clear removes every entry from the cache.
+func (rc *ResponseCache) Clear() {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	rc.entries = make(map[string]*list.Element)
+	rc.recent = list.New()
+}
+
+// Wrap returns a HandleResourceRequestFunc that answers from the cache when it
+// can and otherwise calls next, caching successful responses.  It is a
+// Middleware, so add it to a DefaultClient with Use; to add caching to any
+// other Client that makes resource requests:
+//
+//	previous := client.SetHandleResourceRequestFunc(nil)
+//	client.SetHandleResourceRequestFunc(cache.Wrap(previous))
+func (rc *ResponseCache) Wrap(next HandleResourceRequestFunc) HandleResourceRequestFunc {
+	return func(resourcePath string) ([]byte, error) {
+		body, found := rc.Get(resourcePath)
+		if collector := rc.metricsCollector(); collector != nil {
+			collector.ObserveCacheLookup(endpointForPath(resourcePathWithoutAPIKey(resourcePath)), found)
+		}
+
+		if found {
+			return body, nil
+		}
+
+		body, err := next(resourcePath)
+		if err == nil {
+			rc.Set(resourcePath, body)
+		}
+
+		return body, err
+	}
+}
@@ -0,0 +1,163 @@
+package strainapiclient
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestResponseCacheWrap(t *testing.T) {
+	calls := 0
+	handler := func(resourcePath string) ([]byte, error) {
+		calls++
+		if resourcePath == "error" {
+			return make([]byte, 0), errors.New(resourcePath)
+		}
+		return []byte(resourcePath), nil
+	}
+
+	now := time.Date(2020, 6, 29, 0, 0, 0, 0, time.UTC)
+	cache := NewResponseCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	cachedHandler := cache.Wrap(handler)
+
+	for i := 0; i < 3; i++ {
+		if body, err := cachedHandler("path"); err != nil || string(body) != "path" {
+			t.Fatalf("Expected the response for path, got %q and %v", body, err)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected one call before the entry expires, got %d", calls)
+	}
+
+	now = now.Add(time.Minute)
+	_, _ = cachedHandler("path")
+
+	if calls != 2 {
+		t.Errorf("Expected another call once the entry expired, got %d calls", calls)
+	}
+
+	_, _ = cachedHandler("error")
+	_, _ = cachedHandler("error")
+
+	if calls != 4 {
+		t.Errorf("Expected errors not to be cached, got %d calls", calls)
+	}
+
+	cache.Clear()
+	_, _ = cachedHandler("path")
+
+	if calls != 5 {
+		t.Errorf("Expected a call after the cache was cleared, got %d calls", calls)
+	}
+}
+
+func TestResponseCacheRemovesResponsesPastMaxStale(t *testing.T) {
+	now := time.Date(2020, 6, 29, 0, 0, 0, 0, time.UTC)
+	cache := NewResponseCache(time.Minute)
+	cache.now = func() time.Time { return now }
+	if previous := cache.SetMaxStale(time.Hour); previous != DefaultMaxStale {
+		t.Errorf("Expected the default max stale age, got %v", previous)
+	}
+
+	cache.Set("kept", []byte("kept"))
+	cache.Set("removed by Get", []byte("removed by Get"))
+	cache.Set("removed by Set", []byte("removed by Set"))
+
+	now = now.Add(30 * time.Minute)
+	if body, err := cache.Stale("kept"); err != nil || string(body) != "kept" {
+		t.Errorf("Expected an expired response within the max stale age, got %q, %v", body, err)
+	}
+
+	now = now.Add(time.Hour)
+	if _, found := cache.Get("removed by Get"); found {
+		t.Error("Expected no response past the max stale age")
+	}
+	if _, found := cache.entries["removed by Get"]; found {
+		t.Error("Expected Get to remove the response past the max stale age")
+	}
+
+	cache.Set("new", []byte("new"))
+	if len(cache.entries) != 1 {
+		t.Errorf("Expected Set to remove every response past the max stale age, got %d entries", len(cache.entries))
+	}
+	if _, err := cache.Stale("kept"); err != errNotCached {
+		t.Errorf("Expected no stale response past the max stale age, got %v", err)
+	}
+}
+
+func TestResponseCacheEvictsLeastRecentlyUsedPastMaxEntries(t *testing.T) {
+	cache := NewResponseCache(time.Hour)
+	if previous := cache.SetMaxEntries(3); previous != 0 {
+		t.Errorf("Expected no max entries by default, got %d", previous)
+	}
+
+	for _, resourcePath := range []string{"first", "second", "third"} {
+		cache.Set(resourcePath, []byte(resourcePath))
+	}
+
+	// Using first makes second the least recently used.
+	if _, found := cache.Get("first"); !found {
+		t.Fatal("Expected first to be cached")
+	}
+
+	for index := 0; index < 10; index++ {
+		cache.Set(fmt.Sprintf("search %d", index), []byte("results"))
+		if len(cache.entries) > 3 {
+			t.Fatalf("Expected no more than 3 entries, got %d", len(cache.entries))
+		}
+	}
+
+	for _, resourcePath := range []string{"search 7", "search 8", "search 9"} {
+		if _, found := cache.Get(resourcePath); !found {
+			t.Errorf("Expected the most recent response %q to be kept", resourcePath)
+		}
+	}
+
+	cache = NewResponseCache(time.Hour)
+	for _, resourcePath := range []string{"first", "second", "third"} {
+		cache.Set(resourcePath, []byte(resourcePath))
+	}
+	_, _ = cache.Get("first")
+	cache.SetMaxEntries(2)
+
+	if _, found := cache.Get("second"); found {
+		t.Error("Expected the least recently used response to be evicted")
+	}
+	if _, found := cache.Get("first"); !found {
+		t.Error("Expected the recently used response to be kept")
+	}
+}
+
+func TestResponseCacheSweepEvery(t *testing.T) {
+	cache := NewResponseCache(time.Minute)
+	cache.SetMaxStale(0)
+	cache.Set("expired", []byte("expired"))
+
+	cache.mutex.Lock()
+	cache.now = func() time.Time { return time.Now().Add(time.Hour) }
+	cache.mutex.Unlock()
+
+	stop := cache.SweepEvery(time.Millisecond)
+	defer stop()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		cache.mutex.Lock()
+		remaining := len(cache.entries)
+		cache.mutex.Unlock()
+
+		if remaining == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Expected SweepEvery to remove the expired response")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	stop()
+}
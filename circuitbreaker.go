@@ -0,0 +1,181 @@
+package strainapiclient
+
+import (
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of a CircuitBreaker.
+type CircuitState int
+
+// The states of a CircuitBreaker.
+const (
+	// CircuitClosed lets every request through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails every request without calling the API.
+	CircuitOpen
+	// CircuitHalfOpen lets one trial request through to see whether the API
+	// has recovered.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// CircuitBreakerSettings configures a CircuitBreaker.
+type CircuitBreakerSettings struct {
+	// FailureThreshold is how many requests in a row have to fail to open
+	// the circuit.  It is 5 if zero.
+	FailureThreshold int
+	// CoolDown is how long the circuit stays open before a trial request is
+	// let through.  It is 30 seconds if zero.
+	CoolDown time.Duration
+	// Fallback, if set, answers the requests made while the circuit is
+	// open, such as ResponseCache.Stale or the HandleResourceRequest of a
+	// snapshot of the catalog.  If it fails, or is not set, they fail with
+	// ErrCircuitOpen.
+	Fallback HandleResourceRequestFunc
+	// OnStateChange, if set, is called whenever the circuit changes state.
+	// It must not call the CircuitBreaker.
+	OnStateChange func(from CircuitState, to CircuitState)
+}
+
+// CircuitBreaker stops calling the API once requests to it keep failing,
+// so callers fail fast with ErrCircuitOpen rather than each waiting for it,
+// and lets a request through every cool-down to find out whether it is
+// back.  Only the failures RetryMiddleware would retry count: connection
+// errors and 429 and 5xx statuses.  It is safe for concurrent use.
+type CircuitBreaker struct {
+	mutex    sync.Mutex
+	settings CircuitBreakerSettings
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	trying   bool
+	now      func() time.Time
+}
+
+// NewCircuitBreaker creates a closed CircuitBreaker.
+func NewCircuitBreaker(settings CircuitBreakerSettings) *CircuitBreaker {
+	if settings.FailureThreshold <= 0 {
+		settings.FailureThreshold = 5
+	}
+	if settings.CoolDown <= 0 {
+		settings.CoolDown = 30 * time.Second
+	}
+
+	return &CircuitBreaker{settings: settings, now: time.Now}
+}
+
+// State returns the state of the circuit.
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	if cb.state == CircuitOpen && !cb.now().Before(cb.openedAt.Add(cb.settings.CoolDown)) {
+		return CircuitHalfOpen
+	}
+	return cb.state
+}
+
+// allow reports whether a request may be made, and whether it is the trial
+// request of a half-open circuit.
+func (cb *CircuitBreaker) allow() (allowed bool, trial bool) {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	if cb.state == CircuitOpen && !cb.now().Before(cb.openedAt.Add(cb.settings.CoolDown)) {
+		cb.setState(CircuitHalfOpen)
+	}
+
+	switch cb.state {
+	case CircuitClosed:
+		return true, false
+	case CircuitHalfOpen:
+		if cb.trying {
+			return false, false
+		}
+		cb.trying = true
+		return true, true
+	}
+
+	return false, false
+}
+
+// record records the outcome of a request that was let through.
+func (cb *CircuitBreaker) record(err error, trial bool) {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	if trial {
+		cb.trying = false
+	}
+
+	if err == nil || !isRetryable(err) {
+		cb.failures = 0
+		if trial {
+			cb.setState(CircuitClosed)
+		}
+		return
+	}
+
+	cb.failures++
+	if trial || cb.failures >= cb.settings.FailureThreshold {
+		cb.openedAt = cb.now()
+		cb.setState(CircuitOpen)
+	}
+}
+
+// setState changes the state, calling OnStateChange.  It is called with the
+// mutex held.
+func (cb *CircuitBreaker) setState(state CircuitState) {
+	if state == cb.state {
+		return
+	}
+
+	previous := cb.state
+	cb.state = state
+	if state == CircuitClosed {
+		cb.failures = 0
+	}
+
+	if cb.settings.OnStateChange != nil {
+		cb.settings.OnStateChange(previous, state)
+	}
+}
+
+// Wrap returns a HandleResourceRequestFunc that calls next while the
+// circuit is closed and the Fallback, or fails with ErrCircuitOpen, while
+// it is open.  It is a Middleware, so add it to a DefaultClient with Use.
+func (cb *CircuitBreaker) Wrap(next HandleResourceRequestFunc) HandleResourceRequestFunc {
+	return func(resourcePath string) ([]byte, error) {
+		allowed, trial := cb.allow()
+		if !allowed {
+			if cb.settings.Fallback != nil {
+				if body, err := cb.settings.Fallback(resourcePath); err == nil {
+					return body, nil
+				}
+			}
+			return make([]byte, 0), ErrCircuitOpen
+		}
+
+		body, err := next(resourcePath)
+		cb.record(err, trial)
+		return body, err
+	}
+}
+
+// CircuitBreakerMiddleware stops calling the API while it is failing; see
+// CircuitBreaker.Wrap.
+func CircuitBreakerMiddleware(breaker *CircuitBreaker) Middleware {
+	return breaker.Wrap
+}
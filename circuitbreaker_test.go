@@ -0,0 +1,116 @@
+package strainapiclient
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerWrap(t *testing.T) {
+	now := time.Date(2020, 6, 29, 0, 0, 0, 0, time.UTC)
+	changes := make([]string, 0)
+
+	breaker := NewCircuitBreaker(CircuitBreakerSettings{
+		FailureThreshold: 2,
+		CoolDown:         time.Minute,
+		OnStateChange: func(from CircuitState, to CircuitState) {
+			changes = append(changes, from.String()+" to "+to.String())
+		},
+	})
+	breaker.now = func() time.Time { return now }
+
+	calls := 0
+	var upstreamErr error
+	handler := breaker.Wrap(func(resourcePath string) ([]byte, error) {
+		calls++
+		if upstreamErr != nil {
+			return make([]byte, 0), upstreamErr
+		}
+		return []byte(resourcePath), nil
+	})
+
+	// Errors that say nothing about the health of the API do not count.
+	upstreamErr = &StatusError{StatusCode: http.StatusNotFound}
+	_, _ = handler("path")
+	_, _ = handler("path")
+	if breaker.State() != CircuitClosed {
+		t.Fatalf("Expected a 404 not to open the circuit, got %s", breaker.State())
+	}
+
+	upstreamErr = &ConnectionError{Err: errors.New("connection refused")}
+	_, _ = handler("path")
+	_, _ = handler("path")
+	if breaker.State() != CircuitOpen {
+		t.Fatalf("Expected the circuit to open after 2 failures, got %s", breaker.State())
+	}
+
+	calls = 0
+	if _, err := handler("path"); err != ErrCircuitOpen || calls != 0 {
+		t.Errorf("Expected ErrCircuitOpen without calling the API, got %v after %d calls", err, calls)
+	}
+
+	// After the cool-down one trial request is let through; its failure
+	// opens the circuit again.
+	now = now.Add(time.Minute)
+	if breaker.State() != CircuitHalfOpen {
+		t.Errorf("Expected the circuit to be half-open after the cool-down, got %s", breaker.State())
+	}
+	if _, err := handler("path"); err != upstreamErr || calls != 1 {
+		t.Errorf("Expected the trial request to reach the API, got %v after %d calls", err, calls)
+	}
+	if _, err := handler("path"); err != ErrCircuitOpen || calls != 1 {
+		t.Errorf("Expected the failed trial to open the circuit again, got %v after %d calls", err, calls)
+	}
+
+	now = now.Add(time.Minute)
+	upstreamErr = nil
+	if body, err := handler("path"); err != nil || string(body) != "path" {
+		t.Errorf("Expected the trial request to succeed, got %q, %v", body, err)
+	}
+	if breaker.State() != CircuitClosed {
+		t.Errorf("Expected a successful trial to close the circuit, got %s", breaker.State())
+	}
+
+	expected := []string{"closed to open", "open to half-open", "half-open to open", "open to half-open", "half-open to closed"}
+	if len(changes) != len(expected) {
+		t.Fatalf("Expected the state changes %v, got %v", expected, changes)
+	}
+	for index := range expected {
+		if changes[index] != expected[index] {
+			t.Errorf("Expected the state changes %v, got %v", expected, changes)
+			break
+		}
+	}
+}
+
+func TestCircuitBreakerFallsBackToStaleResponses(t *testing.T) {
+	cache := NewResponseCache(time.Minute)
+	now := time.Date(2020, 6, 29, 0, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	breaker := NewCircuitBreaker(CircuitBreakerSettings{FailureThreshold: 1, Fallback: cache.Stale})
+
+	failing := false
+	handler := Chain(cache.Wrap, breaker.Wrap)(func(resourcePath string) ([]byte, error) {
+		if failing {
+			return make([]byte, 0), &StatusError{StatusCode: http.StatusServiceUnavailable}
+		}
+		return []byte(resourcePath), nil
+	})
+
+	_, _ = handler("cached")
+
+	now = now.Add(time.Hour)
+	failing = true
+	if _, err := handler("cached"); err == nil {
+		t.Fatal("Expected the request that opens the circuit to fail")
+	}
+
+	if body, err := handler("cached"); err != nil || string(body) != "cached" {
+		t.Errorf("Expected the expired response while the circuit is open, got %q, %v", body, err)
+	}
+	if _, err := handler("never cached"); err != ErrCircuitOpen {
+		t.Errorf("Expected ErrCircuitOpen without a cached response, got %v", err)
+	}
+}
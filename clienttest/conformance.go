@@ -0,0 +1,383 @@
+package clienttest
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+// ClientFactory creates the Client under test.  The Client it returns
+// must serve the data in KnownDataset().
+type ClientFactory func(t *testing.T) strainapiclient.Client
+
+// noSuchTerm is a search term that never matches anything in the KnownDataset.
+const noSuchTerm string = "zzz-no-such-term"
+
+// RunClientConformance exercises every method of the Client interface against
+// the KnownDataset and reports any behavior that differs from the DefaultClient.
+// Each check runs as a subtest with a fresh Client from the factory.
+func RunClientConformance(t *testing.T, factory ClientFactory) {
+	dataset := KnownDataset()
+
+	t.Run("ListAllEffects", func(t *testing.T) { checkListAllEffects(t, factory(t), dataset) })
+	t.Run("ListAllFlavors", func(t *testing.T) { checkListAllFlavors(t, factory(t), dataset) })
+	t.Run("ListAllStrains", func(t *testing.T) { checkListAllStrains(t, factory(t), dataset) })
+	t.Run("SearchStrainsByName", func(t *testing.T) { checkSearchStrainsByName(t, factory(t), dataset) })
+	t.Run("SearchStrainsByRace", func(t *testing.T) { checkSearchStrainsByRace(t, factory(t), dataset) })
+	t.Run("SearchStrainsByFlavor", func(t *testing.T) { checkSearchStrainsByFlavor(t, factory(t), dataset) })
+	t.Run("SearchStrainsByEffectName", func(t *testing.T) { checkSearchStrainsByEffectName(t, factory(t), dataset) })
+	t.Run("GetStrainDescriptionByStrainID", func(t *testing.T) { checkGetStrainDescriptionByStrainID(t, factory(t), dataset) })
+	t.Run("GetStrainFlavorsByStrainID", func(t *testing.T) { checkGetStrainFlavorsByStrainID(t, factory(t), dataset) })
+	t.Run("GetStrainEffectsByStrainID", func(t *testing.T) { checkGetStrainEffectsByStrainID(t, factory(t), dataset) })
+	t.Run("SetHandleResourceRequestFunc", func(t *testing.T) { checkSetHandleResourceRequestFunc(t, factory(t)) })
+}
+
+func checkListAllEffects(t *testing.T, client strainapiclient.Client, dataset Dataset) {
+	effects, err := client.ListAllEffects()
+	if err != nil {
+		t.Fatalf("ListAllEffects returned an error: %s", err)
+	}
+
+	expectedTypes := make(map[string]strainapiclient.EffectType)
+	for _, effect := range dataset.Effects {
+		expectedTypes[effect.Name] = effect.Type
+	}
+
+	if len(effects) != len(expectedTypes) {
+		t.Errorf("Expected %d effects, got %d: %v", len(expectedTypes), len(effects), effects)
+	}
+
+	for _, effect := range effects {
+		expectedType, found := expectedTypes[effect.Name]
+		if !found {
+			t.Errorf("Unexpected effect %q", effect.Name)
+			continue
+		}
+
+		if effect.Type != expectedType {
+			t.Errorf("Expected effect %q to have EffectType %q, got %q", effect.Name, expectedType, effect.Type)
+		}
+	}
+}
+
+func checkListAllFlavors(t *testing.T, client strainapiclient.Client, dataset Dataset) {
+	flavors, err := client.ListAllFlavors()
+	if err != nil {
+		t.Fatalf("ListAllFlavors returned an error: %s", err)
+	}
+
+	if !sameFlavors(flavors, dataset.Flavors) {
+		t.Errorf("Expected flavors %v, got %v", dataset.Flavors, flavors)
+	}
+}
+
+func checkListAllStrains(t *testing.T, client strainapiclient.Client, dataset Dataset) {
+	strains, err := client.ListAllStrains()
+	if err != nil {
+		t.Fatalf("ListAllStrains returned an error: %s", err)
+	}
+
+	if len(strains) != len(dataset.Strains) {
+		t.Errorf("Expected %d strains, got %d", len(dataset.Strains), len(strains))
+	}
+
+	for _, expected := range dataset.Strains {
+		actual, found := strains[expected.Name]
+		if !found {
+			t.Errorf("Expected strain %q to be keyed by its name", expected.Name)
+			continue
+		}
+
+		if actual.Name != expected.Name {
+			t.Errorf("Expected the Name of strain %q to be populated from its key, got %q", expected.Name, actual.Name)
+		}
+
+		if actual.ID != expected.ID || actual.Race != expected.Race {
+			t.Errorf("Expected strain %q to have ID %d and Race %q, got %d and %q", expected.Name, expected.ID, expected.Race, actual.ID, actual.Race)
+		}
+
+		if !sameFlavors(actual.Flavors, expected.Flavors) {
+			t.Errorf("Expected strain %q to have flavors %v, got %v", expected.Name, expected.Flavors, actual.Flavors)
+		}
+
+		for _, effectType := range effectTypes() {
+			if !sameStrings(actual.Effects[effectType], expected.Effects[effectType]) {
+				t.Errorf("Expected strain %q to have %s effects %v, got %v", expected.Name, effectType, expected.Effects[effectType], actual.Effects[effectType])
+			}
+		}
+	}
+}
+
+func checkSearchStrainsByName(t *testing.T, client strainapiclient.Client, dataset Dataset) {
+	for _, expected := range dataset.Strains {
+		results, err := client.SearchStrainsByName(expected.Name)
+		if err != nil {
+			t.Errorf("SearchStrainsByName(%q) returned an error: %s", expected.Name, err)
+			continue
+		}
+
+		found := false
+		for _, result := range results {
+			if result.ID != expected.ID {
+				continue
+			}
+
+			found = true
+			if result.Name != expected.Name || result.Race != expected.Race || result.Description != expected.Description {
+				t.Errorf("SearchStrainsByName(%q) expected %v, got %v", expected.Name, expected, result)
+			}
+		}
+
+		if !found {
+			t.Errorf("SearchStrainsByName(%q) did not return the strain with ID %d: %v", expected.Name, expected.ID, results)
+		}
+	}
+
+	results, err := client.SearchStrainsByName(noSuchTerm)
+	checkEmptySearch(t, "SearchStrainsByName", len(results), results == nil, err)
+}
+
+func checkSearchStrainsByRace(t *testing.T, client strainapiclient.Client, dataset Dataset) {
+	for _, race := range []strainapiclient.Race{strainapiclient.RaceIndica, strainapiclient.RaceSativa, strainapiclient.RaceHybrid} {
+		results, err := client.SearchStrainsByRace(race)
+		if err != nil {
+			t.Errorf("SearchStrainsByRace(%q) returned an error: %s", race, err)
+			continue
+		}
+
+		actualIDs := make([]int, 0)
+		for _, result := range results {
+			actualIDs = append(actualIDs, result.ID)
+			if result.Race != race || result.Name == "" {
+				t.Errorf("SearchStrainsByRace(%q) returned an unexpected result: %v", race, result)
+			}
+		}
+
+		expectedIDs := make([]int, 0)
+		for _, strain := range dataset.Strains {
+			if strain.Race == race {
+				expectedIDs = append(expectedIDs, strain.ID)
+			}
+		}
+
+		if !sameInts(actualIDs, expectedIDs) {
+			t.Errorf("SearchStrainsByRace(%q) expected IDs %v, got %v", race, expectedIDs, actualIDs)
+		}
+	}
+
+	results, err := client.SearchStrainsByRace(strainapiclient.Race(noSuchTerm))
+	checkEmptySearch(t, "SearchStrainsByRace", len(results), results == nil, err)
+}
+
+func checkSearchStrainsByFlavor(t *testing.T, client strainapiclient.Client, dataset Dataset) {
+	for _, flavor := range dataset.Flavors {
+		results, err := client.SearchStrainsByFlavor(flavor)
+		if err != nil {
+			t.Errorf("SearchStrainsByFlavor(%q) returned an error: %s", flavor, err)
+			continue
+		}
+
+		actualIDs := make([]int, 0)
+		for _, result := range results {
+			actualIDs = append(actualIDs, result.ID)
+			if result.Flavor != flavor || result.Name == "" {
+				t.Errorf("SearchStrainsByFlavor(%q) returned an unexpected result: %v", flavor, result)
+			}
+		}
+
+		expectedIDs := make([]int, 0)
+		for _, strain := range dataset.Strains {
+			for _, strainFlavor := range strain.Flavors {
+				if strainFlavor == flavor {
+					expectedIDs = append(expectedIDs, strain.ID)
+				}
+			}
+		}
+
+		if !sameInts(actualIDs, expectedIDs) {
+			t.Errorf("SearchStrainsByFlavor(%q) expected IDs %v, got %v", flavor, expectedIDs, actualIDs)
+		}
+	}
+
+	results, err := client.SearchStrainsByFlavor(strainapiclient.Flavor(noSuchTerm))
+	checkEmptySearch(t, "SearchStrainsByFlavor", len(results), results == nil, err)
+}
+
+func checkSearchStrainsByEffectName(t *testing.T, client strainapiclient.Client, dataset Dataset) {
+	for _, effect := range dataset.Effects {
+		results, err := client.SearchStrainsByEffectName(effect.Name)
+		if err != nil {
+			t.Errorf("SearchStrainsByEffectName(%q) returned an error: %s", effect.Name, err)
+			continue
+		}
+
+		actualIDs := make([]int, 0)
+		for _, result := range results {
+			actualIDs = append(actualIDs, result.ID)
+			if result.EffectName != effect.Name || result.Name == "" {
+				t.Errorf("SearchStrainsByEffectName(%q) returned an unexpected result: %v", effect.Name, result)
+			}
+		}
+
+		expectedIDs := make([]int, 0)
+		for _, strain := range dataset.Strains {
+			for _, effectName := range strain.Effects[effect.Type] {
+				if effectName == effect.Name {
+					expectedIDs = append(expectedIDs, strain.ID)
+				}
+			}
+		}
+
+		if !sameInts(actualIDs, expectedIDs) {
+			t.Errorf("SearchStrainsByEffectName(%q) expected IDs %v, got %v", effect.Name, expectedIDs, actualIDs)
+		}
+	}
+
+	results, err := client.SearchStrainsByEffectName(noSuchTerm)
+	checkEmptySearch(t, "SearchStrainsByEffectName", len(results), results == nil, err)
+}
+
+func checkGetStrainDescriptionByStrainID(t *testing.T, client strainapiclient.Client, dataset Dataset) {
+	for _, expected := range dataset.Strains {
+		description, err := client.GetStrainDescriptionByStrainID(expected.ID)
+		if err != nil {
+			t.Errorf("GetStrainDescriptionByStrainID(%d) returned an error: %s", expected.ID, err)
+			continue
+		}
+
+		if description != expected.Description {
+			t.Errorf("GetStrainDescriptionByStrainID(%d) expected %q, got %q", expected.ID, expected.Description, description)
+		}
+	}
+
+	description, err := client.GetStrainDescriptionByStrainID(UnknownStrainID)
+	if err == nil {
+		t.Errorf("GetStrainDescriptionByStrainID(%d) expected an error for an unknown ID, got %q", UnknownStrainID, description)
+	}
+}
+
+func checkGetStrainFlavorsByStrainID(t *testing.T, client strainapiclient.Client, dataset Dataset) {
+	for _, expected := range dataset.Strains {
+		flavors, err := client.GetStrainFlavorsByStrainID(expected.ID)
+		if err != nil {
+			t.Errorf("GetStrainFlavorsByStrainID(%d) returned an error: %s", expected.ID, err)
+			continue
+		}
+
+		if !sameFlavors(flavors, expected.Flavors) {
+			t.Errorf("GetStrainFlavorsByStrainID(%d) expected %v, got %v", expected.ID, expected.Flavors, flavors)
+		}
+	}
+
+	// An unknown ID may be reported as an error or as no flavors, but never as data
+	flavors, _ := client.GetStrainFlavorsByStrainID(UnknownStrainID)
+	if len(flavors) != 0 {
+		t.Errorf("GetStrainFlavorsByStrainID(%d) expected no flavors for an unknown ID, got %v", UnknownStrainID, flavors)
+	}
+}
+
+func checkGetStrainEffectsByStrainID(t *testing.T, client strainapiclient.Client, dataset Dataset) {
+	for _, expected := range dataset.Strains {
+		effectsByEffectType, err := client.GetStrainEffectsByStrainID(expected.ID)
+		if err != nil {
+			t.Errorf("GetStrainEffectsByStrainID(%d) returned an error: %s", expected.ID, err)
+			continue
+		}
+
+		for _, effectType := range effectTypes() {
+			effectNames := make([]string, 0)
+
+			for _, effect := range effectsByEffectType[effectType] {
+				effectNames = append(effectNames, effect.Name)
+				if effect.Type != effectType {
+					t.Errorf("GetStrainEffectsByStrainID(%d) expected effect %q under %q to have that EffectType, got %q", expected.ID, effect.Name, effectType, effect.Type)
+				}
+			}
+
+			if !sameStrings(effectNames, expected.Effects[effectType]) {
+				t.Errorf("GetStrainEffectsByStrainID(%d) expected %s effects %v, got %v", expected.ID, effectType, expected.Effects[effectType], effectNames)
+			}
+		}
+	}
+
+	// An unknown ID may be reported as an error or as no effects, but never as data
+	effectsByEffectType, _ := client.GetStrainEffectsByStrainID(UnknownStrainID)
+	for effectType, effects := range effectsByEffectType {
+		if len(effects) != 0 {
+			t.Errorf("GetStrainEffectsByStrainID(%d) expected no effects for an unknown ID, got %s effects %v", UnknownStrainID, effectType, effects)
+		}
+	}
+}
+
+func checkSetHandleResourceRequestFunc(t *testing.T, client strainapiclient.Client) {
+	replacement := func(resourcePath string) ([]byte, error) {
+		return KnownDataset().HandleResourceRequest(resourcePath)
+	}
+
+	previous := client.SetHandleResourceRequestFunc(replacement)
+	restored := client.SetHandleResourceRequestFunc(previous)
+
+	if restored == nil || reflect.ValueOf(restored).Pointer() != reflect.ValueOf(replacement).Pointer() {
+		t.Errorf("Expected SetHandleResourceRequestFunc to return the function that was previously set")
+	}
+}
+
+func checkEmptySearch(t *testing.T, methodName string, count int, isNil bool, err error) {
+	if err != nil {
+		t.Errorf("%s(%q) expected no error for a search without matches, got %s", methodName, noSuchTerm, err)
+	}
+
+	if count != 0 || isNil {
+		t.Errorf("%s(%q) expected an empty, non-nil result, got %d results", methodName, noSuchTerm, count)
+	}
+}
+
+func effectTypes() []strainapiclient.EffectType {
+	return []strainapiclient.EffectType{
+		strainapiclient.EffectTypePositive,
+		strainapiclient.EffectTypeNegative,
+		strainapiclient.EffectTypeMedical,
+	}
+}
+
+func sameFlavors(actual []strainapiclient.Flavor, expected []strainapiclient.Flavor) bool {
+	actualStrings := make([]string, len(actual))
+	for index, flavor := range actual {
+		actualStrings[index] = string(flavor)
+	}
+
+	expectedStrings := make([]string, len(expected))
+	for index, flavor := range expected {
+		expectedStrings[index] = string(flavor)
+	}
+
+	return sameStrings(actualStrings, expectedStrings)
+}
+
+func sameStrings(actual []string, expected []string) bool {
+	if len(actual) != len(expected) {
+		return false
+	}
+
+	sortedActual := append([]string(nil), actual...)
+	sortedExpected := append([]string(nil), expected...)
+	sort.Strings(sortedActual)
+	sort.Strings(sortedExpected)
+
+	return reflect.DeepEqual(sortedActual, sortedExpected)
+}
+
+func sameInts(actual []int, expected []int) bool {
+	if len(actual) != len(expected) {
+		return false
+	}
+
+	sortedActual := append([]int(nil), actual...)
+	sortedExpected := append([]int(nil), expected...)
+	sort.Ints(sortedActual)
+	sort.Ints(sortedExpected)
+
+	return reflect.DeepEqual(sortedActual, sortedExpected)
+}
@@ -0,0 +1,19 @@
+package clienttest
+
+import (
+	"testing"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+func TestDefaultClientConformance(t *testing.T) {
+	RunClientConformance(t, func(t *testing.T) strainapiclient.Client {
+		return NewFixtureClient()
+	})
+}
+
+func TestFixtureClientCanConnect(t *testing.T) {
+	if !NewFixtureClient().CanConnect() {
+		t.Error("Expected the fixture client to be able to connect")
+	}
+}
@@ -0,0 +1,292 @@
+// Package clienttest provides a known dataset, an offline resource handler
+// that serves it in the same shape as The Strain API, and a conformance suite
+// that any strainapiclient.Client implementation can be run against.
+package clienttest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+// UnknownStrainID is a strain ID that is never present in the KnownDataset.
+const UnknownStrainID int = 999999
+
+// FixtureAPIKey is the API Key used by clients created with NewFixtureClient.
+const FixtureAPIKey string = "clienttest-api-key"
+
+// Dataset is a small, fixed catalog of effects, flavors and strains.
+type Dataset struct {
+	Effects []strainapiclient.Effect
+	Flavors []strainapiclient.Flavor
+	Strains []strainapiclient.Strain
+}
+
+// KnownDataset returns the dataset the conformance suite expects a
+// Client to serve.  A new copy is returned on every call so callers
+// are free to modify it.
+func KnownDataset() Dataset {
+	return Dataset{
+		Effects: []strainapiclient.Effect{
+			{Name: "Relaxed", Type: strainapiclient.EffectTypePositive},
+			{Name: "Happy", Type: strainapiclient.EffectTypePositive},
+			{Name: "Hungry", Type: strainapiclient.EffectTypePositive},
+			{Name: "Sleepy", Type: strainapiclient.EffectTypePositive},
+			{Name: "Euphoric", Type: strainapiclient.EffectTypePositive},
+			{Name: "Energetic", Type: strainapiclient.EffectTypePositive},
+			{Name: "Creative", Type: strainapiclient.EffectTypePositive},
+			{Name: "Dizzy", Type: strainapiclient.EffectTypeNegative},
+			{Name: "Dry Mouth", Type: strainapiclient.EffectTypeNegative},
+			{Name: "Paranoid", Type: strainapiclient.EffectTypeNegative},
+			{Name: "Depression", Type: strainapiclient.EffectTypeMedical},
+			{Name: "Insomnia", Type: strainapiclient.EffectTypeMedical},
+			{Name: "Pain", Type: strainapiclient.EffectTypeMedical},
+			{Name: "Stress", Type: strainapiclient.EffectTypeMedical},
+			{Name: "Lack of Appetite", Type: strainapiclient.EffectTypeMedical},
+			{Name: "Fatigue", Type: strainapiclient.EffectTypeMedical},
+		},
+		Flavors: []strainapiclient.Flavor{
+			"Earthy", "Chemical", "Pine", "Citrus", "Lemon", "Sweet", "Berry", "Woody", "Diesel",
+		},
+		Strains: []strainapiclient.Strain{
+			{
+				Name:        "Afpak",
+				ID:          1,
+				Description: "Afpak, named for its direct Afghani and Pakistani landrace heritage, is a beautiful indica-dominant hybrid.",
+				Race:        strainapiclient.RaceHybrid,
+				Flavors:     []strainapiclient.Flavor{"Earthy", "Chemical", "Pine"},
+				Effects: map[strainapiclient.EffectType][]string{
+					strainapiclient.EffectTypePositive: {"Relaxed", "Hungry", "Happy", "Sleepy"},
+					strainapiclient.EffectTypeNegative: {"Dizzy"},
+					strainapiclient.EffectTypeMedical:  {"Depression", "Insomnia", "Pain", "Stress", "Lack of Appetite"},
+				},
+			},
+			{
+				Name:        "Afghani",
+				ID:          2,
+				Description: "Afghani is a pure indica with a deep, earthy aroma and heavy body effects.",
+				Race:        strainapiclient.RaceIndica,
+				Flavors:     []strainapiclient.Flavor{"Earthy", "Woody", "Pine"},
+				Effects: map[strainapiclient.EffectType][]string{
+					strainapiclient.EffectTypePositive: {"Relaxed", "Sleepy", "Happy"},
+					strainapiclient.EffectTypeNegative: {"Dry Mouth", "Dizzy"},
+					strainapiclient.EffectTypeMedical:  {"Insomnia", "Pain", "Stress"},
+				},
+			},
+			{
+				Name:        "Super Lemon Haze",
+				ID:          3,
+				Description: "Super Lemon Haze is a zesty sativa with an energetic, uplifting buzz.",
+				Race:        strainapiclient.RaceSativa,
+				Flavors:     []strainapiclient.Flavor{"Lemon", "Citrus", "Sweet"},
+				Effects: map[strainapiclient.EffectType][]string{
+					strainapiclient.EffectTypePositive: {"Energetic", "Happy", "Euphoric", "Creative"},
+					strainapiclient.EffectTypeNegative: {"Dry Mouth", "Paranoid"},
+					strainapiclient.EffectTypeMedical:  {"Stress", "Depression", "Fatigue"},
+				},
+			},
+			{
+				Name:        "Blueberry",
+				ID:          4,
+				Description: "Blueberry is an indica known for its sweet berry aroma and long-lasting calm.",
+				Race:        strainapiclient.RaceIndica,
+				Flavors:     []strainapiclient.Flavor{"Berry", "Sweet"},
+				Effects: map[strainapiclient.EffectType][]string{
+					strainapiclient.EffectTypePositive: {"Relaxed", "Happy", "Euphoric", "Sleepy"},
+					strainapiclient.EffectTypeNegative: {"Dry Mouth"},
+					strainapiclient.EffectTypeMedical:  {"Stress", "Pain", "Insomnia"},
+				},
+			},
+			{
+				Name:        "Sour Diesel",
+				ID:          5,
+				Description: "Sour Diesel is a fast-acting sativa with a pungent, diesel-like aroma.",
+				Race:        strainapiclient.RaceSativa,
+				Flavors:     []strainapiclient.Flavor{"Diesel", "Earthy", "Citrus"},
+				Effects: map[strainapiclient.EffectType][]string{
+					strainapiclient.EffectTypePositive: {"Energetic", "Happy", "Creative"},
+					strainapiclient.EffectTypeNegative: {"Paranoid", "Dry Mouth"},
+					strainapiclient.EffectTypeMedical:  {"Fatigue", "Depression", "Stress"},
+				},
+			},
+		},
+	}
+}
+
+// StrainByID returns the Strain in the Dataset with the id passed in
+// and whether it was found.
+func (d Dataset) StrainByID(id int) (strainapiclient.Strain, bool) {
+	for _, strain := range d.Strains {
+		if strain.ID == id {
+			return strain, true
+		}
+	}
+
+	return strainapiclient.Strain{}, false
+}
+
+// NewFixtureClient creates a DefaultClient whose requests are served by the
+// KnownDataset rather than making live HTTP calls.
+func NewFixtureClient() *strainapiclient.DefaultClient {
+	client := strainapiclient.NewDefaultClient(FixtureAPIKey)
+	client.SetHandleResourceRequestFunc(KnownDataset().HandleResourceRequest)
+	return client
+}
+
+// HandleResourceRequest is a strainapiclient.HandleResourceRequestFunc that
+// answers requests from the Dataset, using the same JSON shapes as The
+// Strain API.  Use it with SetHandleResourceRequestFunc to run a
+// DefaultClient offline.
+func (d Dataset) HandleResourceRequest(resourcePath string) ([]byte, error) {
+	parsedURL, err := url.Parse(resourcePath)
+	if err != nil {
+		return make([]byte, 0), &strainapiclient.ConnectionError{Err: err}
+	}
+
+	// The first segment of the path is always the API Key
+	segments := strings.Split(strings.TrimPrefix(parsedURL.Path, "/"), "/")
+	if len(segments) == 1 || (len(segments) == 2 && segments[1] == "") {
+		return []byte("Seems legit to me man..."), nil
+	}
+
+	response, found := d.respond(segments[1:])
+	if !found {
+		return make([]byte, 0), &strainapiclient.StatusError{StatusCode: http.StatusNotFound, Body: "Not Found"}
+	}
+
+	return json.Marshal(response)
+}
+
+func (d Dataset) respond(segments []string) (interface{}, bool) {
+	switch {
+	case len(segments) == 2 && segments[0] == "searchdata" && segments[1] == "effects":
+		return d.Effects, true
+
+	case len(segments) == 2 && segments[0] == "searchdata" && segments[1] == "flavors":
+		return d.Flavors, true
+
+	case len(segments) == 3 && segments[0] == "strains" && segments[1] == "search" && segments[2] == "all":
+		return d.allStrains(), true
+
+	case len(segments) == 4 && segments[0] == "strains" && segments[1] == "search":
+		return d.search(segments[2], segments[3])
+
+	case len(segments) == 4 && segments[0] == "strains" && segments[1] == "data":
+		id, err := strconv.Atoi(segments[3])
+		if err != nil {
+			return nil, false
+		}
+		return d.data(segments[2], id)
+	}
+
+	return nil, false
+}
+
+type allStrainsEntry struct {
+	ID      int                                     `json:"id"`
+	Race    strainapiclient.Race                    `json:"race"`
+	Flavors []strainapiclient.Flavor                `json:"flavors"`
+	Effects map[strainapiclient.EffectType][]string `json:"effects"`
+}
+
+func (d Dataset) allStrains() map[string]allStrainsEntry {
+	result := make(map[string]allStrainsEntry)
+
+	for _, strain := range d.Strains {
+		result[strain.Name] = allStrainsEntry{
+			ID:      strain.ID,
+			Race:    strain.Race,
+			Flavors: strain.Flavors,
+			Effects: strain.Effects,
+		}
+	}
+
+	return result
+}
+
+func (d Dataset) search(searchType string, term string) (interface{}, bool) {
+	switch searchType {
+	case "name":
+		results := make(strainapiclient.SearchStrainsByNameResults, 0)
+		for _, strain := range d.Strains {
+			if strings.Contains(strings.ToLower(strain.Name), strings.ToLower(term)) {
+				results = append(results, strainapiclient.SearchStrainsByNameResult{
+					Name: strain.Name, ID: strain.ID, Race: strain.Race, Description: strain.Description,
+				})
+			}
+		}
+		return results, true
+
+	case "race":
+		results := make(strainapiclient.SearchStrainsByRaceResults, 0)
+		for _, strain := range d.Strains {
+			if string(strain.Race) == term {
+				results = append(results, strainapiclient.SearchStrainsByRaceResult{
+					Name: strain.Name, ID: strain.ID, Race: strain.Race,
+				})
+			}
+		}
+		return results, true
+
+	case "flavor":
+		results := make(strainapiclient.SearchStrainsByFlavorResults, 0)
+		for _, strain := range d.Strains {
+			for _, flavor := range strain.Flavors {
+				if string(flavor) == term {
+					results = append(results, strainapiclient.SearchStrainsByFlavorResult{
+						Name: strain.Name, ID: strain.ID, Race: strain.Race, Flavor: flavor,
+					})
+				}
+			}
+		}
+		return results, true
+
+	case "effect":
+		results := make(strainapiclient.SearchStrainsByEffectNameResults, 0)
+		for _, strain := range d.Strains {
+			for _, effectNames := range strain.Effects {
+				for _, effectName := range effectNames {
+					if effectName == term {
+						results = append(results, strainapiclient.SearchStrainsByEffectNameResult{
+							Name: strain.Name, ID: strain.ID, Race: strain.Race, EffectName: effectName,
+						})
+					}
+				}
+			}
+		}
+		return results, true
+	}
+
+	return nil, false
+}
+
+func (d Dataset) data(dataElementName string, id int) (interface{}, bool) {
+	strain, found := d.StrainByID(id)
+
+	switch dataElementName {
+	case "desc":
+		// The API answers with a null description for IDs it does not know
+		if !found {
+			return map[string]interface{}{"desc": nil}, true
+		}
+		return map[string]string{"desc": strain.Description}, true
+
+	case "flavors":
+		if !found {
+			return make([]strainapiclient.Flavor, 0), true
+		}
+		return strain.Flavors, true
+
+	case "effects":
+		if !found {
+			return make(map[string][]string), true
+		}
+		return strain.Effects, true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,287 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+// command runs against a Client and returns the result to render.
+type command func(client strainapiclient.Client) (result, error)
+
+// parseCommand finds the command named in args and parses its flags.
+func parseCommand(args []string, opts *options) (command, error) {
+	if len(args) == 0 {
+		return nil, usageError{errors.New("No command given")}
+	}
+
+	switch {
+	case args[0] == "effects":
+		return parseNoArgs(args, opts, listEffects)
+	case args[0] == "flavors":
+		return parseNoArgs(args, opts, listFlavors)
+	case args[0] == "strains" && len(args) > 1 && args[1] == "list":
+		return parseNoArgs(args[1:], opts, listStrains)
+	case args[0] == "strains" && len(args) > 1 && args[1] == "search":
+		return parseSearchStrains(args[1:], opts)
+	case args[0] == "strain" && len(args) > 1 && args[1] == "show":
+		return parseShowStrain(args[1:], opts)
+	}
+
+	return nil, usageError{fmt.Errorf("Unknown command %q", strings.Join(args, " "))}
+}
+
+// newFlagSet creates a FlagSet for a command that also accepts the
+// global options.  Errors are reported by the caller, not the FlagSet.
+func newFlagSet(name string, opts *options) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+	opts.register(flags)
+	return flags
+}
+
+// parseFlags parses args, allowing flags to come after positional
+// arguments (as in "strain show 1 --output json"), and returns the
+// positional arguments.
+func parseFlags(flags *flag.FlagSet, args []string) ([]string, error) {
+	positional := make([]string, 0)
+
+	for {
+		if err := flags.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, usageError{err}
+		}
+
+		if flags.NArg() == 0 {
+			return positional, nil
+		}
+
+		positional = append(positional, flags.Arg(0))
+		args = flags.Args()[1:]
+	}
+}
+
+func parseNoArgs(args []string, opts *options, cmd command) (command, error) {
+	positional, err := parseFlags(newFlagSet(args[0], opts), args[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(positional) > 0 {
+		return nil, usageError{fmt.Errorf("Unexpected arguments: %s", strings.Join(positional, " "))}
+	}
+
+	return cmd, nil
+}
+
+func listEffects(client strainapiclient.Client) (result, error) {
+	effects, err := client.ListAllEffects()
+	if err != nil {
+		return result{}, err
+	}
+
+	r := result{header: []string{"NAME", "TYPE"}, value: effects}
+	for _, effect := range effects {
+		r.rows = append(r.rows, []string{effect.Name, string(effect.Type)})
+	}
+
+	return r, nil
+}
+
+func listFlavors(client strainapiclient.Client) (result, error) {
+	flavors, err := client.ListAllFlavors()
+	if err != nil {
+		return result{}, err
+	}
+
+	r := result{header: []string{"FLAVOR"}, value: flavors}
+	for _, flavor := range flavors {
+		r.rows = append(r.rows, []string{string(flavor)})
+	}
+
+	return r, nil
+}
+
+func listStrains(client strainapiclient.Client) (result, error) {
+	allStrains, err := client.ListAllStrains()
+	if err != nil {
+		return result{}, err
+	}
+
+	strains := make([]strainapiclient.Strain, 0, len(allStrains))
+	for _, strain := range allStrains {
+		strains = append(strains, strain)
+	}
+	sort.Slice(strains, func(i, j int) bool { return strains[i].ID < strains[j].ID })
+
+	r := result{header: []string{"ID", "NAME", "RACE", "FLAVORS"}, value: strains}
+	for _, strain := range strains {
+		r.rows = append(r.rows, []string{strconv.Itoa(strain.ID), strain.Name, string(strain.Race), joinFlavors(strain.Flavors)})
+	}
+
+	return r, nil
+}
+
+func parseSearchStrains(args []string, opts *options) (command, error) {
+	var race, flavor, effect, name string
+
+	flags := newFlagSet("search", opts)
+	flags.StringVar(&race, "race", "", "search by race (indica, sativa or hybrid)")
+	flags.StringVar(&flavor, "flavor", "", "search by flavor")
+	flags.StringVar(&effect, "effect", "", "search by effect name")
+	flags.StringVar(&name, "name", "", "search by (part of) a strain name")
+
+	positional, err := parseFlags(flags, args[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	searchCount := 0
+	for _, term := range []string{race, flavor, effect, name} {
+		if term != "" {
+			searchCount++
+		}
+	}
+
+	if searchCount != 1 || len(positional) > 0 {
+		return nil, usageError{errors.New("strains search needs exactly one of --race, --flavor, --effect or --name")}
+	}
+
+	var parsedRace strainapiclient.Race
+	if race != "" {
+		if parsedRace, err = strainapiclient.ParseRace(race); err != nil {
+			return nil, usageError{err}
+		}
+	}
+
+	return func(client strainapiclient.Client) (result, error) {
+		switch {
+		case race != "":
+			return searchStrainsByRace(client, parsedRace)
+		case flavor != "":
+			return searchStrainsByFlavor(client, strainapiclient.Flavor(flavor))
+		case effect != "":
+			return searchStrainsByEffectName(client, effect)
+		default:
+			return searchStrainsByName(client, name)
+		}
+	}, nil
+}
+
+func searchStrainsByRace(client strainapiclient.Client, race strainapiclient.Race) (result, error) {
+	strains, err := client.SearchStrainsByRace(race)
+	if err != nil {
+		return result{}, err
+	}
+
+	r := result{header: []string{"ID", "NAME", "RACE"}, value: strains}
+	for _, strain := range strains {
+		r.rows = append(r.rows, []string{strconv.Itoa(strain.ID), strain.Name, string(strain.Race)})
+	}
+
+	return r, nil
+}
+
+func searchStrainsByFlavor(client strainapiclient.Client, flavor strainapiclient.Flavor) (result, error) {
+	strains, err := client.SearchStrainsByFlavor(flavor)
+	if err != nil {
+		return result{}, err
+	}
+
+	r := result{header: []string{"ID", "NAME", "RACE", "FLAVOR"}, value: strains}
+	for _, strain := range strains {
+		r.rows = append(r.rows, []string{strconv.Itoa(strain.ID), strain.Name, string(strain.Race), string(strain.Flavor)})
+	}
+
+	return r, nil
+}
+
+func searchStrainsByEffectName(client strainapiclient.Client, effectName string) (result, error) {
+	strains, err := client.SearchStrainsByEffectName(effectName)
+	if err != nil {
+		return result{}, err
+	}
+
+	r := result{header: []string{"ID", "NAME", "RACE", "EFFECT"}, value: strains}
+	for _, strain := range strains {
+		r.rows = append(r.rows, []string{strconv.Itoa(strain.ID), strain.Name, string(strain.Race), strain.EffectName})
+	}
+
+	return r, nil
+}
+
+func searchStrainsByName(client strainapiclient.Client, name string) (result, error) {
+	strains, err := client.SearchStrainsByName(name)
+	if err != nil {
+		return result{}, err
+	}
+
+	r := result{header: []string{"ID", "NAME", "RACE", "DESCRIPTION"}, value: strains}
+	for _, strain := range strains {
+		r.rows = append(r.rows, []string{strconv.Itoa(strain.ID), strain.Name, string(strain.Race), strain.Description})
+	}
+
+	return r, nil
+}
+
+func parseShowStrain(args []string, opts *options) (command, error) {
+	positional, err := parseFlags(newFlagSet("show", opts), args[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(positional) != 1 {
+		return nil, usageError{errors.New("strain show needs exactly one strain ID")}
+	}
+
+	id, err := strconv.Atoi(positional[0])
+	if err != nil {
+		return nil, usageError{fmt.Errorf("Invalid strain ID %q", positional[0])}
+	}
+
+	return func(client strainapiclient.Client) (result, error) {
+		return showStrain(client, id)
+	}, nil
+}
+
+func showStrain(client strainapiclient.Client, id int) (result, error) {
+	strain, err := strainapiclient.GetStrainByID(client, id)
+	if err != nil {
+		return result{}, err
+	}
+
+	r := result{
+		header: []string{"ID", "NAME", "RACE", "FLAVORS", "POSITIVE", "NEGATIVE", "MEDICAL", "DESCRIPTION"},
+		rows: [][]string{{
+			strconv.Itoa(strain.ID),
+			strain.Name,
+			string(strain.Race),
+			joinFlavors(strain.Flavors),
+			strings.Join(strain.Effects[strainapiclient.EffectTypePositive], ", "),
+			strings.Join(strain.Effects[strainapiclient.EffectTypeNegative], ", "),
+			strings.Join(strain.Effects[strainapiclient.EffectTypeMedical], ", "),
+			strain.Description,
+		}},
+		vertical: true,
+		value:    strain,
+	}
+
+	return r, nil
+}
+
+func joinFlavors(flavors []strainapiclient.Flavor) string {
+	names := make([]string, len(flavors))
+	for index, flavor := range flavors {
+		names[index] = string(flavor)
+	}
+
+	return strings.Join(names, ", ")
+}
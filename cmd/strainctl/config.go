@@ -0,0 +1,64 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const apiKeyEnvironmentVariableName string = "STRAIN_API_KEY"
+
+// config is the contents of the optional JSON config file.
+type config struct {
+	APIKey string `json:"api_key"`
+	Output string `json:"output"`
+}
+
+// defaultConfigPath is strainctl/config.json in the user's config directory.
+func defaultConfigPath() string {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(configDir, "strainctl", "config.json")
+}
+
+// loadConfig reads the config file at path.  A missing file is not an
+// error; it just means there is no config.
+func loadConfig(path string) (config, error) {
+	cfg := config{}
+	if path == "" {
+		return cfg, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, usageError{fmt.Errorf("Problem reading config file %s: %s", path, err)}
+	}
+
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, usageError{fmt.Errorf("Problem parsing config file %s: %s", path, err)}
+	}
+
+	return cfg, nil
+}
+
+// apiKey returns the API Key from the environment, falling back to the config file.
+func (c config) apiKey() (string, error) {
+	if apiKey, found := os.LookupEnv(apiKeyEnvironmentVariableName); found && apiKey != "" {
+		return apiKey, nil
+	}
+
+	if c.APIKey != "" {
+		return c.APIKey, nil
+	}
+
+	return "", usageError{fmt.Errorf("No API Key found; set %s or api_key in the config file", apiKeyEnvironmentVariableName)}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"net/http"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+// Exit codes returned by strainctl.
+const (
+	exitOK          = 0
+	exitError       = 1
+	exitUsage       = 2
+	exitNotFound    = 3
+	exitRejected    = 4 // the API refused the request, usually because of the API Key
+	exitUnavailable = 5 // the API could not be reached, had a server error or its circuit breaker is open
+	exitBadResponse = 6 // the API answered with something that could not be understood
+)
+
+// usageError is returned when the command line or config file is invalid.
+type usageError struct {
+	err error
+}
+
+func (e usageError) Error() string {
+	return e.err.Error()
+}
+
+func (e usageError) Unwrap() error {
+	return e.err
+}
+
+// exitCodeFor maps an error returned by a command to the exit code for it.
+func exitCodeFor(err error) int {
+	var usageErr usageError
+	var statusErr *strainapiclient.StatusError
+	var connectionErr *strainapiclient.ConnectionError
+	var schemaErr *strainapiclient.SchemaError
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+
+	switch {
+	case err == nil || errors.Is(err, flag.ErrHelp):
+		return exitOK
+	case errors.As(err, &usageErr):
+		return exitUsage
+	case errors.Is(err, strainapiclient.ErrStrainNotFound) || errors.Is(err, strainapiclient.ErrDescriptionNotFound):
+		return exitNotFound
+	case errors.As(err, &statusErr):
+		switch {
+		case statusErr.StatusCode == http.StatusNotFound:
+			return exitNotFound
+		case statusErr.StatusCode >= http.StatusInternalServerError:
+			return exitUnavailable
+		default:
+			return exitRejected
+		}
+	case errors.As(err, &connectionErr) || errors.Is(err, strainapiclient.ErrCircuitOpen):
+		return exitUnavailable
+	case errors.As(err, &schemaErr) || errors.As(err, &syntaxErr) || errors.As(err, &typeErr):
+		return exitBadResponse
+	}
+
+	return exitError
+}
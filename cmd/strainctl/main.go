@@ -0,0 +1,145 @@
+// Command strainctl calls The Strain API from the command line.
+//
+// Usage:
+//
+//	strainctl [--output table|json|yaml|csv] [--config path] <command> [arguments]
+//
+// The commands are:
+//
+//	effects                   list all effects
+//	flavors                   list all flavors
+//	strains list              list all strains
+//	strains search            search strains with one of --race, --flavor, --effect or --name
+//	strain show <id>          show everything known about a single strain
+//	repl                      explore the catalog interactively
+//
+// The API Key is read from the STRAIN_API_KEY environment variable or, if
+// that is not set, from the "api_key" field of the JSON config file.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+const usage string = `Usage: strainctl [--output table|json|yaml|csv] [--config path] <command> [arguments]
+
+Commands:
+  effects                                         list all effects
+  flavors                                         list all flavors
+  strains list                                    list all strains
+  strains search --race|--flavor|--effect|--name  search strains
+  strain show <id>                                show a single strain
+  repl [--cache-ttl duration]                     explore the catalog interactively
+`
+
+// newClientFunc creates the Client used to run a command.
+type newClientFunc func(apiKey string) strainapiclient.Client
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr, newDefaultClient))
+}
+
+func newDefaultClient(apiKey string) strainapiclient.Client {
+	return strainapiclient.NewDefaultClient(apiKey)
+}
+
+// options are the flags that can be given before or after the command.
+type options struct {
+	output     string
+	configPath string
+}
+
+func (o *options) register(flags *flag.FlagSet) {
+	flags.StringVar(&o.output, "output", o.output, "output format: table, json, yaml or csv")
+	flags.StringVar(&o.configPath, "config", o.configPath, "path to the JSON config file")
+}
+
+// run executes the command in args and returns the process exit code.
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer, newClient newClientFunc) int {
+	opts := &options{configPath: defaultConfigPath()}
+
+	globalFlags := flag.NewFlagSet("strainctl", flag.ContinueOnError)
+	globalFlags.SetOutput(stderr)
+	globalFlags.Usage = func() { fmt.Fprint(stderr, usage) }
+	opts.register(globalFlags)
+
+	if err := globalFlags.Parse(args); err != nil {
+		return exitCodeFor(usageError{err})
+	}
+
+	var cmd command
+	var replOpts *replOptions
+	if commandArgs := globalFlags.Args(); len(commandArgs) > 0 && commandArgs[0] == "repl" {
+		parsedREPLOpts, err := parseREPL(commandArgs, opts)
+		if err != nil {
+			return reportError(stderr, err)
+		}
+		replOpts = &parsedREPLOpts
+	} else {
+		parsedCmd, err := parseCommand(commandArgs, opts)
+		if err != nil {
+			return reportError(stderr, err)
+		}
+		cmd = parsedCmd
+	}
+
+	cfg, err := loadConfig(opts.configPath)
+	if err != nil {
+		return reportError(stderr, err)
+	}
+
+	output := opts.output
+	if output == "" {
+		output = cfg.Output
+	}
+	if output == "" {
+		output = outputTable
+	}
+	if !isValidOutput(output) {
+		return reportError(stderr, usageError{fmt.Errorf("Unknown output format %q", output)})
+	}
+
+	apiKey, err := cfg.apiKey()
+	if err != nil {
+		return reportError(stderr, err)
+	}
+
+	client := newClient(apiKey)
+
+	if replOpts != nil {
+		if err := runREPL(client, stdin, stdout, output, *replOpts); err != nil {
+			return reportError(stderr, err)
+		}
+		return exitOK
+	}
+
+	result, err := cmd(client)
+	if err != nil {
+		return reportError(stderr, err)
+	}
+
+	if err := render(stdout, output, result); err != nil {
+		return reportError(stderr, err)
+	}
+
+	return exitOK
+}
+
+func reportError(stderr io.Writer, err error) int {
+	if !errors.Is(err, flag.ErrHelp) {
+		fmt.Fprintf(stderr, "strainctl: %s\n", err)
+	}
+
+	var usageErr usageError
+	if errors.As(err, &usageErr) {
+		fmt.Fprint(stderr, usage)
+	}
+
+	return exitCodeFor(err)
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+func runWithFixtureClient(t *testing.T, args ...string) (int, string, string) {
+	return runWithFixtureClientAndInput(t, "", args...)
+}
+
+func runWithFixtureClientAndInput(t *testing.T, input string, args ...string) (int, string, string) {
+	if err := os.Setenv(apiKeyEnvironmentVariableName, clienttest.FixtureAPIKey); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Unsetenv(apiKeyEnvironmentVariableName)
+
+	var stdout, stderr bytes.Buffer
+	newClient := func(apiKey string) strainapiclient.Client {
+		return clienttest.NewFixtureClient()
+	}
+
+	// Never pick up a real config file
+	args = append([]string{"--config", ""}, args...)
+
+	code := run(args, strings.NewReader(input), &stdout, &stderr, newClient)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestEffectsAsJSON(t *testing.T) {
+	code, stdout, stderr := runWithFixtureClient(t, "--output", "json", "effects")
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d: %s", exitOK, code, stderr)
+	}
+
+	var effects []strainapiclient.Effect
+	if err := json.Unmarshal([]byte(stdout), &effects); err != nil {
+		t.Fatalf("Expected JSON output, got %s: %s", stdout, err)
+	}
+
+	if len(effects) != len(clienttest.KnownDataset().Effects) {
+		t.Errorf("Expected %d effects, got %d", len(clienttest.KnownDataset().Effects), len(effects))
+	}
+}
+
+func TestSearchStrainsByRaceAsTable(t *testing.T) {
+	code, stdout, stderr := runWithFixtureClient(t, "strains", "search", "--race", "indica")
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d: %s", exitOK, code, stderr)
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "ID") || !strings.Contains(lines[1], "Afghani") {
+		t.Errorf("Expected a header and two indica strains, got:\n%s", stdout)
+	}
+}
+
+func TestShowStrainAsYAML(t *testing.T) {
+	code, stdout, stderr := runWithFixtureClient(t, "strain", "show", "3", "--output", "yaml")
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d: %s", exitOK, code, stderr)
+	}
+
+	for _, expected := range []string{"id: 3\n", "name: Super Lemon Haze\n", "race: sativa\n", "flavors:\n  - Lemon\n", "  positive:\n    - Energetic\n"} {
+		if !strings.Contains(stdout, expected) {
+			t.Errorf("Expected YAML output to contain %q, got:\n%s", expected, stdout)
+		}
+	}
+}
+
+func TestListFlavorsAsCSV(t *testing.T) {
+	code, stdout, _ := runWithFixtureClient(t, "flavors", "--output", "csv")
+	if code != exitOK || !strings.HasPrefix(stdout, "FLAVOR\nEarthy\n") {
+		t.Errorf("Expected CSV output with a header, got %d:\n%s", code, stdout)
+	}
+}
+
+func TestExitCodes(t *testing.T) {
+	tests := []struct {
+		args     []string
+		expected int
+	}{
+		{[]string{"strains", "list"}, exitOK},
+		{[]string{}, exitUsage},
+		{[]string{"strains"}, exitUsage},
+		{[]string{"strains", "search"}, exitUsage},
+		{[]string{"strains", "search", "--race", "indica", "--name", "Af"}, exitUsage},
+		{[]string{"strain", "show", "one"}, exitUsage},
+		{[]string{"--output", "xml", "effects"}, exitUsage},
+		{[]string{"strain", "show", "999999"}, exitNotFound},
+	}
+
+	for _, test := range tests {
+		if code, _, stderr := runWithFixtureClient(t, test.args...); code != test.expected {
+			t.Errorf("strainctl %s: expected exit code %d, got %d: %s", strings.Join(test.args, " "), test.expected, code, stderr)
+		}
+	}
+}
+
+func TestExitCodeForErrors(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected int
+	}{
+		{&strainapiclient.StatusError{StatusCode: 401}, exitRejected},
+		{&strainapiclient.StatusError{StatusCode: 404}, exitNotFound},
+		{&strainapiclient.StatusError{StatusCode: 503}, exitUnavailable},
+		{&strainapiclient.ConnectionError{Err: errors.New("no route to host")}, exitUnavailable},
+		{&strainapiclient.SchemaError{Endpoint: "ListAllFlavors"}, exitBadResponse},
+		{strainapiclient.ErrDescriptionNotFound, exitNotFound},
+		{errors.New("anything else"), exitError},
+	}
+
+	for _, test := range tests {
+		if code := exitCodeFor(test.err); code != test.expected {
+			t.Errorf("Expected exit code %d for %v, got %d", test.expected, test.err, code)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Output formats
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+	outputCSV   = "csv"
+)
+
+func isValidOutput(output string) bool {
+	switch output {
+	case outputTable, outputJSON, outputYAML, outputCSV:
+		return true
+	}
+
+	return false
+}
+
+// result is what a command produces: rows for table and CSV output and the
+// original value for JSON and YAML output.
+type result struct {
+	header []string
+	rows   [][]string
+	// vertical results are a single record shown as one field per line in a table
+	vertical bool
+	value    interface{}
+}
+
+func render(w io.Writer, output string, r result) error {
+	switch output {
+	case outputJSON:
+		data, err := json.MarshalIndent(r.value, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", data)
+		return err
+
+	case outputYAML:
+		return renderYAML(w, r.value)
+
+	case outputCSV:
+		csvWriter := csv.NewWriter(w)
+		if err := csvWriter.Write(r.header); err != nil {
+			return err
+		}
+		if err := csvWriter.WriteAll(r.rows); err != nil {
+			return err
+		}
+		return csvWriter.Error()
+	}
+
+	return renderTable(w, r)
+}
+
+func renderTable(w io.Writer, r result) error {
+	tableWriter := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	if r.vertical {
+		for _, row := range r.rows {
+			for index, column := range r.header {
+				fmt.Fprintf(tableWriter, "%s\t%s\n", column, row[index])
+			}
+		}
+		return tableWriter.Flush()
+	}
+
+	fmt.Fprintln(tableWriter, strings.Join(r.header, "\t"))
+	for _, row := range r.rows {
+		fmt.Fprintln(tableWriter, strings.Join(row, "\t"))
+	}
+
+	return tableWriter.Flush()
+}
+
+// renderYAML writes value as YAML.  The value is first converted to its JSON
+// form so the YAML uses the same field names and custom marshalling.
+func renderYAML(w io.Writer, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	var generic interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return err
+	}
+
+	for _, line := range yamlLines(generic) {
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// yamlLines returns the YAML lines for a value decoded from JSON, without
+// any indentation for the value itself.
+func yamlLines(value interface{}) []string {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		if len(typedValue) == 0 {
+			return []string{"{}"}
+		}
+
+		keys := make([]string, 0, len(typedValue))
+		for key := range typedValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		lines := make([]string, 0)
+		for _, key := range keys {
+			nested := yamlLines(typedValue[key])
+			if isYAMLScalar(typedValue[key]) {
+				lines = append(lines, yamlScalar(key)+": "+nested[0])
+				continue
+			}
+
+			lines = append(lines, yamlScalar(key)+":")
+			for _, line := range nested {
+				lines = append(lines, "  "+line)
+			}
+		}
+		return lines
+
+	case []interface{}:
+		if len(typedValue) == 0 {
+			return []string{"[]"}
+		}
+
+		lines := make([]string, 0)
+		for _, element := range typedValue {
+			for index, line := range yamlLines(element) {
+				if index == 0 {
+					lines = append(lines, "- "+line)
+				} else {
+					lines = append(lines, "  "+line)
+				}
+			}
+		}
+		return lines
+	}
+
+	return []string{yamlScalar(value)}
+}
+
+// isYAMLScalar is true for values written on the same line as their key,
+// including empty maps and slices.
+func isYAMLScalar(value interface{}) bool {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		return len(typedValue) == 0
+	case []interface{}:
+		return len(typedValue) == 0
+	}
+
+	return true
+}
+
+func yamlScalar(value interface{}) string {
+	switch typedValue := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(typedValue)
+	case float64:
+		return strconv.FormatFloat(typedValue, 'f', -1, 64)
+	case string:
+		if yamlNeedsQuotes(typedValue) {
+			return strconv.Quote(typedValue)
+		}
+		return typedValue
+	}
+
+	return fmt.Sprint(value)
+}
+
+// yamlNeedsQuotes is true for strings that would otherwise be read back as
+// something other than the same string.
+func yamlNeedsQuotes(s string) bool {
+	if s == "" || strings.TrimSpace(s) != s || strings.ContainsAny(s, ":#{}[],&*!|>'\"%@`\n\t\\") {
+		return true
+	}
+
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "?") {
+		return true
+	}
+
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "null", "~":
+		return true
+	}
+
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
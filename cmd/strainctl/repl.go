@@ -0,0 +1,604 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tchype/strainapiclient-go"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const replPrompt string = "strain> "
+
+const replHelp string = `Commands:
+  search race|flavor|effect|name <term>  search strains
+  show <id|name>                         show a single strain
+  compare <id|name> <id|name>...         compare strains side by side (quote names with spaces)
+  pin [<id|name>]                        pin a strain, or the results of the last search or show
+  unpin <id|name>|all                    unpin a strain, or every strain
+  pins                                   list the pinned strains
+  effects, flavors                       list the vocabularies
+  refresh                                clear the cache and reload the vocabularies
+  history                                list the commands entered so far
+  help                                   show this help
+  exit, quit                             leave the REPL
+
+Press Tab to complete commands, strain names, flavors and effects.
+`
+
+var replCommands = []string{
+	"compare", "effects", "exit", "flavors", "help", "history", "pin", "pins", "quit", "refresh", "search", "show", "unpin",
+}
+
+var replSearchTypes = []string{"effect", "flavor", "name", "race"}
+
+// replOptions are the flags of the repl command.
+type replOptions struct {
+	cacheTTL time.Duration
+}
+
+func parseREPL(args []string, opts *options) (replOptions, error) {
+	replOpts := replOptions{cacheTTL: 10 * time.Minute}
+
+	flags := newFlagSet("repl", opts)
+	flags.DurationVar(&replOpts.cacheTTL, "cache-ttl", replOpts.cacheTTL, "how long API responses are cached")
+
+	positional, err := parseFlags(flags, args[1:])
+	if err != nil {
+		return replOpts, err
+	}
+
+	if len(positional) > 0 {
+		return replOpts, usageError{fmt.Errorf("Unexpected arguments: %s", strings.Join(positional, " "))}
+	}
+
+	return replOpts, nil
+}
+
+// repl is an interactive session for exploring the catalog.
+type repl struct {
+	client strainapiclient.Client
+	cache  *strainapiclient.ResponseCache
+	out    io.Writer
+	output string
+
+	// resolver finds strains by name; it is made once for the session and
+	// refreshed along with the vocabularies
+	resolver *strainapiclient.StrainResolver
+
+	strainNames []string
+	flavors     []string
+	effects     []string
+
+	// last holds the strain IDs from the most recent search or show
+	last    []int
+	pins    []int
+	history []string
+}
+
+// lineReader reads one line of input at a time.
+type lineReader interface {
+	ReadLine() (string, error)
+}
+
+type scannerLineReader struct {
+	scanner *bufio.Scanner
+}
+
+func (s scannerLineReader) ReadLine() (string, error) {
+	if s.scanner.Scan() {
+		return s.scanner.Text(), nil
+	}
+
+	if err := s.scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return "", io.EOF
+}
+
+// newLineReader reads from a terminal with history and tab completion when
+// stdin is one, and line by line (so commands can be piped in) otherwise.
+// The returned function restores the terminal.
+func newLineReader(stdin io.Reader, stdout io.Writer, complete func(line string) (string, bool)) (lineReader, io.Writer, func(), error) {
+	file, isFile := stdin.(*os.File)
+	if !isFile || !terminal.IsTerminal(int(file.Fd())) {
+		return scannerLineReader{bufio.NewScanner(stdin)}, stdout, func() {}, nil
+	}
+
+	state, err := terminal.MakeRaw(int(file.Fd()))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	term := terminal.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{stdin, stdout}, replPrompt)
+
+	term.AutoCompleteCallback = func(line string, pos int, key rune) (string, int, bool) {
+		if key != '\t' || pos != len(line) {
+			return "", 0, false
+		}
+
+		completed, ok := complete(line)
+		if !ok {
+			return "", 0, false
+		}
+
+		return completed, len(completed), true
+	}
+
+	restore := func() { _ = terminal.Restore(int(file.Fd()), state) }
+
+	return term, term, restore, nil
+}
+
+// runREPL reads commands until the input ends or the user exits.  API
+// responses are cached for the session so exploring does not keep calling
+// the API.
+func runREPL(client strainapiclient.Client, stdin io.Reader, stdout io.Writer, output string, replOpts replOptions) error {
+	r := &repl{client: client, output: output, cache: strainapiclient.NewResponseCache(replOpts.cacheTTL)}
+
+	// Through Use, the cache takes its place among the other middlewares
+	// and its hits are traced.
+	if defaultClient, ok := client.(*strainapiclient.DefaultClient); ok {
+		defaultClient.Use(r.cache.Wrap)
+	} else if previous := client.SetHandleResourceRequestFunc(nil); previous != nil {
+		client.SetHandleResourceRequestFunc(r.cache.Wrap(previous))
+	}
+
+	lines, out, restore, err := newLineReader(stdin, stdout, r.complete)
+	if err != nil {
+		return err
+	}
+	defer restore()
+	r.out = out
+
+	r.loadVocabulary()
+
+	for {
+		line, err := lines.ReadLine()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		r.history = append(r.history, line)
+
+		if exit := r.execute(line); exit {
+			return nil
+		}
+	}
+}
+
+// loadVocabulary loads the names used for tab completion and for finding
+// strains by name.  Completion is simply unavailable for anything that
+// fails to load.
+func (r *repl) loadVocabulary() {
+	r.strainNames, r.flavors, r.effects = nil, nil, nil
+	_ = r.loadResolver()
+
+	if strains, err := r.client.ListAllStrains(); err == nil {
+		for name := range strains {
+			r.strainNames = append(r.strainNames, name)
+		}
+		sort.Strings(r.strainNames)
+	} else {
+		fmt.Fprintf(r.out, "Unable to load strain names: %s\n", err)
+	}
+
+	if flavors, err := r.client.ListAllFlavors(); err == nil {
+		for _, flavor := range flavors {
+			r.flavors = append(r.flavors, string(flavor))
+		}
+	} else {
+		fmt.Fprintf(r.out, "Unable to load flavors: %s\n", err)
+	}
+
+	if effects, err := r.client.ListAllEffects(); err == nil {
+		for _, effect := range effects {
+			r.effects = append(r.effects, effect.Name)
+		}
+	} else {
+		fmt.Fprintf(r.out, "Unable to load effects: %s\n", err)
+	}
+}
+
+// execute runs a single command line and reports whether the REPL should exit.
+func (r *repl) execute(line string) bool {
+	name, rest := splitCommand(line)
+
+	var err error
+	switch name {
+	case "exit", "quit":
+		return true
+	case "help":
+		fmt.Fprint(r.out, replHelp)
+	case "history":
+		for index, entry := range r.history {
+			fmt.Fprintf(r.out, "%4d  %s\n", index+1, entry)
+		}
+	case "effects":
+		err = r.show(listEffects(r.client))
+	case "flavors":
+		err = r.show(listFlavors(r.client))
+	case "search":
+		err = r.search(rest)
+	case "show":
+		err = r.showStrain(rest)
+	case "compare":
+		err = r.compare(rest)
+	case "pin":
+		err = r.pin(rest)
+	case "unpin":
+		err = r.unpin(rest)
+	case "pins":
+		err = r.showPins()
+	case "refresh":
+		r.cache.Clear()
+		r.loadVocabulary()
+	default:
+		err = fmt.Errorf("Unknown command %q; type help for a list of commands", name)
+	}
+
+	if err != nil {
+		fmt.Fprintf(r.out, "Error: %s\n", err)
+	}
+
+	return false
+}
+
+func (r *repl) show(res result, err error) error {
+	if err != nil {
+		return err
+	}
+
+	return render(r.out, r.output, res)
+}
+
+func (r *repl) search(args string) error {
+	searchType, term := splitCommand(args)
+	if term == "" {
+		return errors.New("Usage: search race|flavor|effect|name <term>")
+	}
+
+	var res result
+	var err error
+
+	switch searchType {
+	case "race":
+		var race strainapiclient.Race
+		if race, err = strainapiclient.ParseRace(term); err == nil {
+			res, err = searchStrainsByRace(r.client, race)
+		}
+	case "flavor":
+		res, err = searchStrainsByFlavor(r.client, strainapiclient.Flavor(term))
+	case "effect":
+		res, err = searchStrainsByEffectName(r.client, term)
+	case "name":
+		res, err = searchStrainsByName(r.client, term)
+	default:
+		return fmt.Errorf("Unknown search %q; use race, flavor, effect or name", searchType)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	r.last = make([]int, 0, len(res.rows))
+	for _, row := range res.rows {
+		if id, err := strconv.Atoi(row[0]); err == nil {
+			r.last = append(r.last, id)
+		}
+	}
+
+	return render(r.out, r.output, res)
+}
+
+func (r *repl) showStrain(args string) error {
+	id, err := r.resolveStrain(args)
+	if err != nil {
+		return err
+	}
+
+	r.last = []int{id}
+
+	return r.show(showStrain(r.client, id))
+}
+
+func (r *repl) compare(args string) error {
+	arguments := splitArguments(args)
+	if len(arguments) < 2 {
+		return errors.New("Usage: compare <id|name> <id|name> [<id|name>...]")
+	}
+
+	ids := make([]int, 0, len(arguments))
+	for _, argument := range arguments {
+		id, err := r.resolveStrain(argument)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+
+	comparison, err := strainapiclient.Compare(r.client, ids...)
+	if err != nil {
+		return err
+	}
+
+	switch r.output {
+	case outputTable:
+		return comparison.WriteTable(r.out)
+	case outputJSON:
+		return comparison.WriteJSON(r.out)
+	}
+
+	rows := comparison.Rows()
+	return render(r.out, r.output, result{header: rows[0], rows: rows[1:], value: comparison})
+}
+
+func (r *repl) pin(args string) error {
+	ids := r.last
+	if args != "" {
+		id, err := r.resolveStrain(args)
+		if err != nil {
+			return err
+		}
+		ids = []int{id}
+	}
+
+	if len(ids) == 0 {
+		return errors.New("Nothing to pin; search or show strains first")
+	}
+
+	for _, id := range ids {
+		if indexOf(r.pins, id) < 0 {
+			r.pins = append(r.pins, id)
+		}
+	}
+
+	fmt.Fprintf(r.out, "%d strain(s) pinned\n", len(r.pins))
+	return nil
+}
+
+func (r *repl) unpin(args string) error {
+	if args == "all" {
+		r.pins = nil
+		return nil
+	}
+
+	id, err := r.resolveStrain(args)
+	if err != nil {
+		return err
+	}
+
+	index := indexOf(r.pins, id)
+	if index < 0 {
+		return fmt.Errorf("Strain %d is not pinned", id)
+	}
+
+	r.pins = append(r.pins[:index], r.pins[index+1:]...)
+	return nil
+}
+
+func (r *repl) showPins() error {
+	allStrains, err := r.client.ListAllStrains()
+	if err != nil {
+		return err
+	}
+
+	strainsByID := make(map[int]strainapiclient.Strain)
+	for _, strain := range allStrains {
+		strainsByID[strain.ID] = strain
+	}
+
+	pinned := make([]strainapiclient.Strain, 0, len(r.pins))
+	res := result{header: []string{"ID", "NAME", "RACE", "FLAVORS"}}
+	for _, id := range r.pins {
+		strain := strainsByID[id]
+		pinned = append(pinned, strain)
+		res.rows = append(res.rows, []string{strconv.Itoa(id), strain.Name, string(strain.Race), joinFlavors(strain.Flavors)})
+	}
+	res.value = pinned
+
+	return render(r.out, r.output, res)
+}
+
+// resolveStrain finds the ID for an argument that is either a strain ID
+// or a strain name (in any case) or slug.
+func (r *repl) resolveStrain(argument string) (int, error) {
+	argument = strings.Trim(strings.TrimSpace(argument), `"`)
+	if argument == "" {
+		return 0, errors.New("Missing strain ID or name")
+	}
+
+	if id, err := strconv.Atoi(argument); err == nil {
+		return id, nil
+	}
+
+	if r.resolver == nil {
+		if err := r.loadResolver(); err != nil {
+			return 0, err
+		}
+	}
+
+	return r.resolver.Resolve(argument)
+}
+
+// loadResolver makes the resolver for the session, or refreshes it once it
+// has been made.
+func (r *repl) loadResolver() error {
+	if r.resolver != nil {
+		return r.resolver.Refresh()
+	}
+
+	resolver, err := strainapiclient.NewStrainResolver(r.client)
+	if err != nil {
+		return err
+	}
+	r.resolver = resolver
+	return nil
+}
+
+// complete is the tab completion for a line, returning the completed line.
+func (r *repl) complete(line string) (string, bool) {
+	name, rest := splitCommand(line)
+	if !strings.Contains(line, " ") {
+		return completeWord("", name, replCommands)
+	}
+
+	prefix := line[:len(line)-len(rest)]
+
+	switch name {
+	case "show", "pin", "unpin":
+		return completeWord(prefix, rest, r.strainNames)
+	case "compare":
+		return completeQuotedArgument(prefix, rest, r.strainNames)
+	case "search":
+		searchType, term := splitCommand(rest)
+		if !strings.Contains(rest, " ") {
+			return completeWord(prefix, searchType, replSearchTypes)
+		}
+
+		prefix = line[:len(line)-len(term)]
+		switch searchType {
+		case "race":
+			return completeWord(prefix, term, []string{string(strainapiclient.RaceIndica), string(strainapiclient.RaceSativa), string(strainapiclient.RaceHybrid)})
+		case "flavor":
+			return completeWord(prefix, term, r.flavors)
+		case "effect":
+			return completeWord(prefix, term, r.effects)
+		case "name":
+			return completeWord(prefix, term, r.strainNames)
+		}
+	}
+
+	return "", false
+}
+
+// completeWord completes partial to the longest prefix shared by every
+// candidate it matches (ignoring case).
+func completeWord(prefix string, partial string, candidates []string) (string, bool) {
+	completion, _, ok := longestCompletion(partial, candidates)
+	if !ok {
+		return "", false
+	}
+
+	return prefix + completion, true
+}
+
+// completeQuotedArgument completes the last of several arguments,
+// quoting it if it contains spaces.
+func completeQuotedArgument(prefix string, rest string, candidates []string) (string, bool) {
+	start := strings.LastIndex(rest, " ") + 1
+	quoted := strings.Count(rest, `"`)%2 == 1
+	if quoted {
+		start = strings.LastIndex(rest, `"`)
+	}
+
+	partial := strings.TrimPrefix(rest[start:], `"`)
+	completion, unique, ok := longestCompletion(partial, candidates)
+	if !ok {
+		return "", false
+	}
+
+	if quoted || strings.Contains(completion, " ") {
+		completion = `"` + completion
+		if unique {
+			completion += `"`
+		}
+	}
+
+	return prefix + rest[:start] + completion, true
+}
+
+// longestCompletion returns the longest prefix shared by the candidates that
+// start with partial, and whether there was only one such candidate.
+func longestCompletion(partial string, candidates []string) (string, bool, bool) {
+	matches := make([]string, 0)
+	for _, candidate := range candidates {
+		if strings.HasPrefix(strings.ToLower(candidate), strings.ToLower(partial)) {
+			matches = append(matches, candidate)
+		}
+	}
+
+	if len(matches) == 0 {
+		return "", false, false
+	}
+
+	common := matches[0]
+	for _, match := range matches[1:] {
+		length := 0
+		for length < len(common) && length < len(match) && strings.EqualFold(common[length:length+1], match[length:length+1]) {
+			length++
+		}
+		common = common[:length]
+	}
+
+	if len(common) < len(partial) {
+		common = partial
+	}
+
+	return common, len(matches) == 1, true
+}
+
+// splitCommand splits the first word from the rest of the line.
+func splitCommand(line string) (string, string) {
+	line = strings.TrimLeft(line, " ")
+	if space := strings.Index(line, " "); space >= 0 {
+		return line[:space], strings.TrimLeft(line[space+1:], " ")
+	}
+
+	return line, ""
+}
+
+// splitArguments splits on spaces, keeping double-quoted arguments together.
+func splitArguments(s string) []string {
+	arguments := make([]string, 0)
+
+	var current strings.Builder
+	inQuotes := false
+	for _, character := range s {
+		switch {
+		case character == '"':
+			inQuotes = !inQuotes
+		case character == ' ' && !inQuotes:
+			if current.Len() > 0 {
+				arguments = append(arguments, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(character)
+		}
+	}
+
+	if current.Len() > 0 {
+		arguments = append(arguments, current.String())
+	}
+
+	return arguments
+}
+
+func indexOf(ids []int, id int) int {
+	for index, candidate := range ids {
+		if candidate == id {
+			return index
+		}
+	}
+
+	return -1
+}
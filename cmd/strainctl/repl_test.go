@@ -0,0 +1,193 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+func TestREPLSession(t *testing.T) {
+	input := strings.Join([]string{
+		"search race sativa",
+		"pin",
+		"show afpak",
+		"compare \"Super Lemon Haze\" 5",
+		"unpin 3",
+		"pins",
+		"show 999999",
+		"bogus",
+		"history",
+		"exit",
+		"show 1",
+	}, "\n")
+
+	code, stdout, stderr := runWithFixtureClientAndInput(t, input, "repl")
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d: %s", exitOK, code, stderr)
+	}
+
+	for _, expected := range []string{
+		"Super Lemon Haze",
+		"2 strain(s) pinned",
+		"Afpak, named for its direct Afghani",
+		"Unique flavors   Lemon, Sweet                          Diesel, Earthy",
+		"Error: Strain not found: no strain with ID 999999",
+		`Error: Unknown command "bogus"`,
+		"   9  history",
+	} {
+		if !strings.Contains(stdout, expected) {
+			t.Errorf("Expected the session output to contain %q, got:\n%s", expected, stdout)
+		}
+	}
+
+	pinsOutput := stdout[strings.LastIndex(stdout, "ID  NAME"):strings.Index(stdout, "Error:")]
+	if !strings.Contains(pinsOutput, "Sour Diesel") || strings.Contains(pinsOutput, "Super Lemon Haze") {
+		t.Errorf("Expected only Sour Diesel to still be pinned, got:\n%s", pinsOutput)
+	}
+
+	if strings.Count(stdout, "DESCRIPTION") != 1 {
+		t.Errorf("Expected commands after exit to be ignored, got:\n%s", stdout)
+	}
+}
+
+func TestREPLCompareRejectsTheSameStrainTwice(t *testing.T) {
+	code, stdout, stderr := runWithFixtureClientAndInput(t, "compare afpak 1\n", "repl")
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d: %s", exitOK, code, stderr)
+	}
+
+	if expected := "Error: Strain ID passed in more than once: 1"; !strings.Contains(stdout, expected) {
+		t.Errorf("Expected the session output to contain %q, got:\n%s", expected, stdout)
+	}
+}
+
+func TestREPLCachesResponses(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+
+	requests := make(map[string]int)
+	handler := client.SetHandleResourceRequestFunc(nil)
+	client.SetHandleResourceRequestFunc(func(resourcePath string) ([]byte, error) {
+		requests[resourcePath]++
+		return handler(resourcePath)
+	})
+
+	input := strings.NewReader("show 1\nshow 1\ncompare 1 2\nrefresh\nshow 1\n")
+	var stdout bytes.Buffer
+
+	if err := runREPL(client, input, &stdout, outputTable, replOptions{cacheTTL: time.Hour}); err != nil {
+		t.Fatal(err)
+	}
+
+	for resourcePath, count := range requests {
+		if count > 2 {
+			t.Errorf("Expected %s to be requested once before and once after refresh, got %d requests", resourcePath, count)
+		}
+	}
+}
+
+type cacheHitTracer struct {
+	hits int
+}
+
+func (t *cacheHitTracer) Start(ctx context.Context, spanName string) (context.Context, strainapiclient.Span) {
+	return ctx, cacheHitSpan{tracer: t}
+}
+
+type cacheHitSpan struct {
+	tracer *cacheHitTracer
+}
+
+func (s cacheHitSpan) SetAttribute(key string, value interface{}) {
+	if key == strainapiclient.AttributeCacheHit && value == true {
+		s.tracer.hits++
+	}
+}
+func (cacheHitSpan) RecordError(err error) {}
+func (cacheHitSpan) End()                  {}
+
+func TestREPLCacheHitsAreTraced(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+	tracer := &cacheHitTracer{}
+	client.SetTracer(tracer)
+
+	input := strings.NewReader("show 1\nshow 1\n")
+	if err := runREPL(client, input, &bytes.Buffer{}, outputTable, replOptions{cacheTTL: time.Hour}); err != nil {
+		t.Fatal(err)
+	}
+
+	if tracer.hits == 0 {
+		t.Error("Expected the second show to be traced as a cache hit")
+	}
+}
+
+func TestREPLResolvesNamesWithoutListingStrainsEachTime(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+
+	listed := 0
+	handler := client.SetHandleResourceRequestFunc(nil)
+	client.SetHandleResourceRequestFunc(func(resourcePath string) ([]byte, error) {
+		if strings.HasSuffix(resourcePath, "/strains/search/all") {
+			listed++
+		}
+		return handler(resourcePath)
+	})
+
+	r := &repl{client: client, out: &bytes.Buffer{}}
+	for _, name := range []string{"afpak", "Afghani", "blueberry", "afpak"} {
+		if _, err := r.resolveStrain(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if listed != 1 {
+		t.Errorf("Expected the strains to be listed once for the session, got %d lists", listed)
+	}
+
+	r.loadVocabulary()
+	if id, err := r.resolveStrain("sour-diesel"); err != nil || id != 5 {
+		t.Errorf("Expected Sour Diesel after a refresh, got %d, %v", id, err)
+	}
+	if listed != 3 {
+		t.Errorf("Expected a refresh to list the strains for completion and the resolver, got %d lists", listed)
+	}
+}
+
+func TestREPLCompletion(t *testing.T) {
+	r := &repl{
+		strainNames: []string{"Afghani", "Afpak", "Super Lemon Haze"},
+		flavors:     []string{"Earthy", "Lemon"},
+		effects:     []string{"Happy", "Hungry"},
+	}
+
+	tests := []struct {
+		line     string
+		expected string
+		ok       bool
+	}{
+		{"sh", "show", true},
+		{"pi", "pin", true},
+		{"show af", "show Af", true},
+		{"show afp", "show Afpak", true},
+		{"show su", "show Super Lemon Haze", true},
+		{"search fl", "search flavor", true},
+		{"search flavor ea", "search flavor Earthy", true},
+		{"search effect H", "search effect H", true},
+		{"search race in", "search race indica", true},
+		{"compare 1 su", `compare 1 "Super Lemon Haze"`, true},
+		{`compare "super le`, `compare "Super Lemon Haze"`, true},
+		{"compare afp", "compare Afpak", true},
+		{"show xyz", "", false},
+		{"frobnicate x", "", false},
+	}
+
+	for _, test := range tests {
+		completed, ok := r.complete(test.line)
+		if completed != test.expected || ok != test.ok {
+			t.Errorf("Completing %q: expected %q (%v), got %q (%v)", test.line, test.expected, test.ok, completed, ok)
+		}
+	}
+}
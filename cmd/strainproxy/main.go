@@ -0,0 +1,74 @@
+// Command strainproxy is a caching mirror of The Strain API.  It serves the
+// same URL layout as the API (/{key}/strains/...), so a DefaultClient can use
+// it by calling SetBaseURL, and forwards cache misses to the API with a
+// single upstream API Key.
+//
+// Usage:
+//
+//	strainproxy [--listen :8080] [--upstream url] [--catalog-ttl 24h] [--data-ttl 1h] [--cache-max-entries n]
+//	           [--cache-sweep-interval 10m] [--client-keys key1,key2]
+//
+// The upstream API Key is read from the STRAIN_API_KEY environment variable.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+const apiKeyEnvironmentVariableName string = "STRAIN_API_KEY"
+
+func main() {
+	listen := flag.String("listen", ":8080", "address to listen on")
+	upstreamURL := flag.String("upstream", "https://strainapi.evanbusse.com", "base URL of The Strain API")
+	catalogTTL := flag.Duration("catalog-ttl", 24*time.Hour, "how long the lists of effects, flavors and all strains are cached")
+	dataTTL := flag.Duration("data-ttl", time.Hour, "how long searches and strain data are cached")
+	maxEntries := flag.Int("cache-max-entries", 10000, "most searches and strain data cached, removing the least recently used (no limit if 0)")
+	sweepInterval := flag.Duration("cache-sweep-interval", 10*time.Minute, "how often expired responses are removed from the cache (only when caching if 0)")
+	clientKeys := flag.String("client-keys", "", "comma-separated API Keys internal clients may use (any key if empty)")
+	flag.Parse()
+
+	apiKey, found := os.LookupEnv(apiKeyEnvironmentVariableName)
+	if !found || apiKey == "" {
+		log.Fatalf("Did not find environment variable '%s'", apiKeyEnvironmentVariableName)
+	}
+
+	upstream := strainapiclient.NewDefaultClient(apiKey)
+	upstream.SetBaseURL(*upstreamURL)
+
+	opts := proxyOptions{catalogTTL: *catalogTTL, dataTTL: *dataTTL, maxEntries: *maxEntries, sweepInterval: *sweepInterval}
+	if *clientKeys != "" {
+		opts.clientKeys = strings.Split(*clientKeys, ",")
+	}
+
+	p := newProxy(upstream, opts)
+	defer p.stop()
+
+	server := &http.Server{Addr: *listen, Handler: p}
+
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		<-signals
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			log.Printf("Problem shutting down: %s", err)
+		}
+	}()
+
+	log.Printf("Mirroring %s on %s", *upstreamURL, *listen)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		log.Fatal(err)
+	}
+}
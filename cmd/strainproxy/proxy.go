@@ -0,0 +1,125 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+// proxy serves the same URL layout as The Strain API (/{key}/strains/...)
+// from a single upstream DefaultClient, caching the responses.
+type proxy struct {
+	upstream *strainapiclient.DefaultClient
+	// clientKeys are the API Keys internal clients may use; any key is
+	// accepted when it is empty
+	clientKeys map[string]bool
+	// stopSweeping stops the caches being swept, if they are
+	stopSweeping []func()
+}
+
+// proxyOptions configure a proxy.
+type proxyOptions struct {
+	// catalogTTL is how long the lists of effects, flavors and all strains are cached
+	catalogTTL time.Duration
+	// dataTTL is how long searches and per-strain data are cached
+	dataTTL time.Duration
+	// maxEntries is the most searches and per-strain responses cached; 0 is
+	// no limit
+	maxEntries int
+	// sweepInterval is how often expired responses are removed from the
+	// caches; 0 is only when new responses are cached
+	sweepInterval time.Duration
+	clientKeys    []string
+}
+
+// newProxy creates a proxy that forwards cache misses to upstream.
+func newProxy(upstream *strainapiclient.DefaultClient, opts proxyOptions) *proxy {
+	catalogCache := strainapiclient.NewResponseCache(opts.catalogTTL)
+	dataCache := strainapiclient.NewResponseCache(opts.dataTTL)
+	dataCache.SetMaxEntries(opts.maxEntries)
+
+	next := upstream.SetHandleResourceRequestFunc(nil)
+	catalogHandler := catalogCache.Wrap(next)
+	dataHandler := dataCache.Wrap(next)
+
+	upstream.SetHandleResourceRequestFunc(func(resourcePath string) ([]byte, error) {
+		if isCatalogResource(resourcePath) {
+			return catalogHandler(resourcePath)
+		}
+		return dataHandler(resourcePath)
+	})
+
+	p := &proxy{upstream: upstream, clientKeys: make(map[string]bool)}
+	for _, key := range opts.clientKeys {
+		p.clientKeys[key] = true
+	}
+
+	if opts.sweepInterval > 0 {
+		p.stopSweeping = append(p.stopSweeping, catalogCache.SweepEvery(opts.sweepInterval), dataCache.SweepEvery(opts.sweepInterval))
+	}
+
+	return p
+}
+
+// stop stops sweeping the caches.
+func (p *proxy) stop() {
+	for _, stopSweeping := range p.stopSweeping {
+		stopSweeping()
+	}
+}
+
+// isCatalogResource is true for the resources that rarely change and are
+// expensive to get.
+func isCatalogResource(resourcePath string) bool {
+	return strings.HasSuffix(resourcePath, "/searchdata/effects") ||
+		strings.HasSuffix(resourcePath, "/searchdata/flavors") ||
+		strings.HasSuffix(resourcePath, "/strains/search/all")
+}
+
+func (p *proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// The first segment of the path is the client's API Key
+	path := strings.TrimPrefix(r.URL.EscapedPath(), "/")
+	key, resourcePath := path, ""
+	if slash := strings.Index(path, "/"); slash >= 0 {
+		key, resourcePath = path[:slash], path[slash:]
+	}
+
+	if key == "" || (len(p.clientKeys) > 0 && !p.clientKeys[key]) {
+		http.Error(w, "Unknown API Key", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := p.upstream.GetResource(resourcePath)
+	if err != nil {
+		writeUpstreamError(w, err)
+		return
+	}
+
+	if resourcePath == "" {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	_, _ = w.Write(body)
+}
+
+// writeUpstreamError passes statuses from the API through and reports
+// anything else as a bad gateway.
+func writeUpstreamError(w http.ResponseWriter, err error) {
+	var statusErr *strainapiclient.StatusError
+	if errors.As(err, &statusErr) {
+		http.Error(w, statusErr.Body, statusErr.StatusCode)
+		return
+	}
+
+	http.Error(w, "Problem calling The Strain API", http.StatusBadGateway)
+}
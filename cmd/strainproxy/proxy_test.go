@@ -0,0 +1,139 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+// countingUpstream is a fixture client that counts the requests that reach it.
+type countingUpstream struct {
+	mutex    sync.Mutex
+	requests map[string]int
+}
+
+func newCountingUpstream() (*strainapiclient.DefaultClient, *countingUpstream) {
+	counter := &countingUpstream{requests: make(map[string]int)}
+	client := clienttest.NewFixtureClient()
+
+	next := client.SetHandleResourceRequestFunc(nil)
+	client.SetHandleResourceRequestFunc(func(resourcePath string) ([]byte, error) {
+		counter.mutex.Lock()
+		counter.requests[resourcePath]++
+		counter.mutex.Unlock()
+		return next(resourcePath)
+	})
+
+	return client, counter
+}
+
+func (c *countingUpstream) count(resourcePath string) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.requests[resourcePath]
+}
+
+func newTestProxyServer(clientKeys ...string) (*httptest.Server, *countingUpstream) {
+	upstream, counter := newCountingUpstream()
+	opts := proxyOptions{catalogTTL: time.Hour, dataTTL: time.Hour, clientKeys: clientKeys}
+	return httptest.NewServer(newProxy(upstream, opts)), counter
+}
+
+func TestDefaultClientThroughProxyConformance(t *testing.T) {
+	server, _ := newTestProxyServer()
+	defer server.Close()
+
+	clienttest.RunClientConformance(t, func(t *testing.T) strainapiclient.Client {
+		client := strainapiclient.NewDefaultClient("internal-service-key")
+		client.SetBaseURL(server.URL)
+		return client
+	})
+}
+
+func TestProxyCachesUpstreamResponses(t *testing.T) {
+	server, counter := newTestProxyServer()
+	defer server.Close()
+
+	for _, key := range []string{"service-a", "service-b", "service-a"} {
+		client := strainapiclient.NewDefaultClient(key)
+		client.SetBaseURL(server.URL)
+
+		if _, err := client.ListAllStrains(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.SearchStrainsByName("Super Lemon"); err != nil {
+			t.Fatal(err)
+		}
+		if !client.CanConnect() {
+			t.Error("Expected to be able to connect through the proxy")
+		}
+	}
+
+	upstreamPrefix := "https://strainapi.evanbusse.com/" + clienttest.FixtureAPIKey
+	for _, resourcePath := range []string{"/strains/search/all", "/strains/search/name/Super%20Lemon", ""} {
+		if count := counter.count(upstreamPrefix + resourcePath); count != 1 {
+			t.Errorf("Expected one upstream request for %q, got %d", resourcePath, count)
+		}
+	}
+}
+
+func TestProxyEvictsPastMaxEntries(t *testing.T) {
+	upstream, counter := newCountingUpstream()
+	p := newProxy(upstream, proxyOptions{catalogTTL: time.Hour, dataTTL: time.Hour, maxEntries: 1, sweepInterval: time.Minute})
+	defer p.stop()
+
+	server := httptest.NewServer(p)
+	defer server.Close()
+
+	client := strainapiclient.NewDefaultClient("internal-service-key")
+	client.SetBaseURL(server.URL)
+	for _, name := range []string{"Afpak", "Blueberry", "Afpak"} {
+		if _, err := client.SearchStrainsByName(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resourcePath := "https://strainapi.evanbusse.com/" + clienttest.FixtureAPIKey + "/strains/search/name/Afpak"
+	if count := counter.count(resourcePath); count != 2 {
+		t.Errorf("Expected Afpak to be requested again once evicted, got %d upstream requests", count)
+	}
+}
+
+func TestProxyResponses(t *testing.T) {
+	server, _ := newTestProxyServer("allowed")
+	defer server.Close()
+
+	tests := []struct {
+		method   string
+		path     string
+		expected int
+	}{
+		{http.MethodGet, "/allowed/searchdata/flavors", http.StatusOK},
+		{http.MethodGet, "/someone-else/searchdata/flavors", http.StatusUnauthorized},
+		{http.MethodGet, "/", http.StatusUnauthorized},
+		{http.MethodGet, "/allowed/strains/nothing-here", http.StatusNotFound},
+		{http.MethodPost, "/allowed/searchdata/flavors", http.StatusMethodNotAllowed},
+	}
+
+	for _, test := range tests {
+		req, err := http.NewRequest(test.method, server.URL+test.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != test.expected {
+			t.Errorf("%s %s: expected status %d, got %d", test.method, test.path, test.expected, resp.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Command strainserver serves the REST API from the restapi package, and the
+// GraphQL gateway from the graphqlapi package at /graphql, on top of The
+// Strain API.  With --grpc-listen it also serves the StrainService from the
+// grpcapi package.  With --webhooks it posts the changes to the catalog found
+// by a watch.Watcher, every --refresh, to each of the URLs.  With
+// --log-requests every request to the upstream is logged to stderr, and with
+// --metrics their Prometheus metrics are served at /metrics.
+//
+// Usage:
+//
+//	strainserver [--listen :8080] [--grpc-listen :9090] [--upstream url] [--refresh 6h] [--cache-ttl 1h]
+//	             [--webhooks url,...] [--log-requests] [--metrics]
+//
+// The API Key is read from the STRAIN_API_KEY environment variable, and the
+// secret webhooks are signed with from STRAIN_WEBHOOK_SECRET.  The
+// upstream can be a strainproxy mirror.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/graphqlapi"
+	"github.com/tchype/strainapiclient-go/grpcapi"
+	"github.com/tchype/strainapiclient-go/restapi"
+	"github.com/tchype/strainapiclient-go/watch"
+	"google.golang.org/grpc"
+)
+
+const apiKeyEnvironmentVariableName string = "STRAIN_API_KEY"
+const webhookSecretEnvironmentVariableName string = "STRAIN_WEBHOOK_SECRET"
+
+func main() {
+	listen := flag.String("listen", ":8080", "address to listen on")
+	grpcListen := flag.String("grpc-listen", "", "address to serve gRPC on (not served if empty)")
+	upstreamURL := flag.String("upstream", "https://strainapi.evanbusse.com", "base URL of The Strain API (or a mirror of it)")
+	refresh := flag.Duration("refresh", 6*time.Hour, "how often the index of strains is refreshed")
+	cacheTTL := flag.Duration("cache-ttl", time.Hour, "how long descriptions are cached")
+	webhooks := flag.String("webhooks", "", "comma-separated URLs to post changes to the catalog to")
+	logRequests := flag.Bool("log-requests", false, "log every request to the upstream to stderr")
+	serveMetrics := flag.Bool("metrics", false, "serve Prometheus metrics of the requests to the upstream at /metrics")
+	flag.Parse()
+
+	apiKey, found := os.LookupEnv(apiKeyEnvironmentVariableName)
+	if !found || apiKey == "" {
+		log.Fatalf("Did not find environment variable '%s'", apiKeyEnvironmentVariableName)
+	}
+
+	client := strainapiclient.NewDefaultClient(apiKey)
+	client.SetBaseURL(*upstreamURL)
+	if *logRequests {
+		client.SetLogger(strainapiclient.NewTextLogger(os.Stderr))
+	}
+	cache := strainapiclient.NewResponseCache(*cacheTTL)
+	client.Use(strainapiclient.CachingMiddleware(cache))
+
+	var metrics *strainapiclient.PrometheusCollector
+	if *serveMetrics {
+		metrics = strainapiclient.NewPrometheusCollector()
+		client.SetMetricsCollector(metrics)
+		cache.SetMetricsCollector(metrics)
+	}
+
+	index := restapi.NewIndex(client)
+	log.Println("Building the index of strains...")
+	if err := index.Refresh(); err != nil {
+		log.Fatalf("Problem building the index of strains: %s", err)
+	}
+
+	stopRefreshing := index.RefreshEvery(*refresh, func(err error) {
+		log.Printf("Problem refreshing the index of strains: %s", err)
+	})
+	defer stopRefreshing()
+
+	if *webhooks != "" {
+		watcher := watch.NewWatcher(client)
+		secret := os.Getenv(webhookSecretEnvironmentVariableName)
+		for _, webhookURL := range strings.Split(*webhooks, ",") {
+			watcher.AddWebhook(watch.Webhook{
+				URL:    strings.TrimSpace(webhookURL),
+				Secret: secret,
+				OnFailure: func(err *watch.DeliveryError) {
+					log.Print(err)
+				},
+			})
+		}
+
+		stopWatching := watcher.WatchEvery(*refresh, func(err error) {
+			log.Printf("Problem checking the catalog for changes: %s", err)
+		})
+		defer stopWatching()
+	}
+
+	gateway, err := graphqlapi.NewGateway(client)
+	if err != nil {
+		log.Fatalf("Problem creating the GraphQL schema: %s", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/graphql", gateway)
+	if metrics != nil {
+		mux.Handle("/metrics", metrics)
+	}
+	mux.Handle("/", restapi.NewServer(client, index))
+
+	server := &http.Server{Addr: *listen, Handler: mux}
+
+	var grpcServer *grpc.Server
+	if *grpcListen != "" {
+		grpcListener, err := net.Listen("tcp", *grpcListen)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		grpcServer = grpc.NewServer()
+		grpcapi.RegisterStrainServiceServer(grpcServer, grpcapi.NewServer(client))
+
+		go func() {
+			log.Printf("Serving gRPC on %s", *grpcListen)
+			if err := grpcServer.Serve(grpcListener); err != nil {
+				log.Fatal(err)
+			}
+		}()
+	}
+
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		<-signals
+
+		if grpcServer != nil {
+			grpcServer.GracefulStop()
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			log.Printf("Problem shutting down: %s", err)
+		}
+	}()
+
+	log.Printf("Serving on %s", *listen)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,312 @@
+package strainapiclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Comparison is a side-by-side comparison of two or more strains.
+// Unique flavors and effects are keyed by strain ID.
+type Comparison struct {
+	Strains       []Strain                        `json:"strains"`
+	SameRace      bool                            `json:"same_race"`
+	SharedFlavors []Flavor                        `json:"shared_flavors"`
+	UniqueFlavors map[int][]Flavor                `json:"unique_flavors"`
+	Effects       map[EffectType]EffectComparison `json:"effects"`
+}
+
+// EffectComparison compares the effects of a single EffectType.
+type EffectComparison struct {
+	Shared []string         `json:"shared"`
+	Unique map[int][]string `json:"unique"`
+}
+
+// Compare gets the strains with the ids passed in from client and compares
+// them.  It lists all strains once for all of them, and returns an error
+// wrapping ErrDuplicateStrainID if an ID is passed in more than once.
+func Compare(client Client, ids ...int) (Comparison, error) {
+	seen := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return Comparison{}, fmt.Errorf("%w: %d", ErrDuplicateStrainID, id)
+		}
+		seen[id] = true
+	}
+
+	allStrains, err := client.ListAllStrains()
+	if err != nil {
+		return Comparison{}, err
+	}
+
+	strains := make([]Strain, 0, len(ids))
+	for _, id := range ids {
+		strain, err := strainFromList(client, allStrains, id)
+		if err != nil {
+			return Comparison{}, err
+		}
+		strains = append(strains, strain)
+	}
+
+	return CompareStrains(strains...), nil
+}
+
+// CompareStrains compares strains that have already been retrieved.
+// Shared and unique values keep the order they have in the strains.
+func CompareStrains(strains ...Strain) Comparison {
+	comparison := Comparison{
+		Strains:       strains,
+		SameRace:      len(strains) > 0,
+		SharedFlavors: make([]Flavor, 0),
+		UniqueFlavors: make(map[int][]Flavor),
+		Effects:       make(map[EffectType]EffectComparison),
+	}
+
+	flavorsByStrain := make([][]string, len(strains))
+	for index, strain := range strains {
+		if strain.Race != strains[0].Race {
+			comparison.SameRace = false
+		}
+
+		flavorsByStrain[index] = make([]string, len(strain.Flavors))
+		for flavorIndex, flavor := range strain.Flavors {
+			flavorsByStrain[index][flavorIndex] = string(flavor)
+		}
+	}
+
+	shared, unique := sharedAndUnique(flavorsByStrain)
+	for _, flavor := range shared {
+		comparison.SharedFlavors = append(comparison.SharedFlavors, Flavor(flavor))
+	}
+	for index, strain := range strains {
+		comparison.UniqueFlavors[strain.ID] = make([]Flavor, 0)
+		for _, flavor := range unique[index] {
+			comparison.UniqueFlavors[strain.ID] = append(comparison.UniqueFlavors[strain.ID], Flavor(flavor))
+		}
+	}
+
+	for _, effectType := range comparedEffectTypes(strains) {
+		effectsByStrain := make([][]string, len(strains))
+		for index, strain := range strains {
+			effectsByStrain[index] = strain.Effects[effectType]
+		}
+
+		shared, unique := sharedAndUnique(effectsByStrain)
+		effectComparison := EffectComparison{Shared: shared, Unique: make(map[int][]string)}
+		for index, strain := range strains {
+			effectComparison.Unique[strain.ID] = unique[index]
+		}
+
+		comparison.Effects[effectType] = effectComparison
+	}
+
+	return comparison
+}
+
+// sharedAndUnique returns the values found in every list and, for each
+// list, the values found in no other list.
+func sharedAndUnique(lists [][]string) ([]string, [][]string) {
+	counts := make(map[string]int)
+	for _, list := range lists {
+		for _, value := range distinct(list) {
+			counts[value]++
+		}
+	}
+
+	shared := make([]string, 0)
+	if len(lists) > 0 {
+		for _, value := range distinct(lists[0]) {
+			if counts[value] == len(lists) {
+				shared = append(shared, value)
+			}
+		}
+	}
+
+	unique := make([][]string, len(lists))
+	for index, list := range lists {
+		unique[index] = make([]string, 0)
+		for _, value := range distinct(list) {
+			if counts[value] == 1 {
+				unique[index] = append(unique[index], value)
+			}
+		}
+	}
+
+	return shared, unique
+}
+
+func distinct(values []string) []string {
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(values))
+
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
+
+// comparedEffectTypes returns the well-known EffectTypes followed by any
+// other EffectType found in the strains.
+func comparedEffectTypes(strains []Strain) []EffectType {
+	effectTypes := []EffectType{EffectTypePositive, EffectTypeNegative, EffectTypeMedical}
+
+	others := make([]string, 0)
+	for _, strain := range strains {
+		for effectType := range strain.Effects {
+			if effectType != EffectTypePositive && effectType != EffectTypeNegative && effectType != EffectTypeMedical {
+				others = append(others, string(effectType))
+			}
+		}
+	}
+
+	sort.Strings(others)
+	for _, effectType := range distinct(others) {
+		effectTypes = append(effectTypes, EffectType(effectType))
+	}
+
+	return effectTypes
+}
+
+// Rows returns the comparison as rows with one column per strain.  The first
+// row is the header of strain names.
+func (c Comparison) Rows() [][]string {
+	header := []string{""}
+	ids := []string{"ID"}
+	races := []string{"Race"}
+	flavors := []string{"Flavors"}
+	uniqueFlavors := []string{"Unique flavors"}
+
+	for _, strain := range c.Strains {
+		header = append(header, strain.Name)
+		ids = append(ids, strconv.Itoa(strain.ID))
+		races = append(races, string(strain.Race))
+		flavors = append(flavors, joinFlavorNames(strain.Flavors))
+		uniqueFlavors = append(uniqueFlavors, joinFlavorNames(c.UniqueFlavors[strain.ID]))
+	}
+
+	rows := [][]string{header, ids, races, flavors, uniqueFlavors}
+
+	for _, effectType := range comparedEffectTypes(c.Strains) {
+		effects := []string{effectTypeTitle(effectType)}
+		uniqueEffects := []string{"Unique " + string(effectType)}
+
+		for _, strain := range c.Strains {
+			effects = append(effects, strings.Join(strain.Effects[effectType], ", "))
+			uniqueEffects = append(uniqueEffects, strings.Join(c.Effects[effectType].Unique[strain.ID], ", "))
+		}
+
+		rows = append(rows, effects, uniqueEffects)
+	}
+
+	return rows
+}
+
+// summary returns the labelled values that apply to all strains at once.
+func (c Comparison) summary() [][2]string {
+	sameRace := "no"
+	if c.SameRace {
+		sameRace = "yes"
+	}
+
+	summary := [][2]string{
+		{"Same race", sameRace},
+		{"Shared flavors", joinFlavorNames(c.SharedFlavors)},
+	}
+
+	for _, effectType := range comparedEffectTypes(c.Strains) {
+		summary = append(summary, [2]string{"Shared " + string(effectType), strings.Join(c.Effects[effectType].Shared, ", ")})
+	}
+
+	return summary
+}
+
+// WriteTable writes the comparison as an aligned table for a terminal.
+func (c Comparison) WriteTable(w io.Writer) error {
+	tableWriter := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	for _, row := range c.Rows() {
+		fmt.Fprintln(tableWriter, strings.Join(row, "\t"))
+	}
+	fmt.Fprintln(tableWriter)
+	for _, line := range c.summary() {
+		fmt.Fprintf(tableWriter, "%s:\t%s\n", line[0], line[1])
+	}
+
+	return tableWriter.Flush()
+}
+
+// WriteMarkdown writes the comparison as a Markdown table followed by a
+// list of what the strains have in common.
+func (c Comparison) WriteMarkdown(w io.Writer) error {
+	escape := strings.NewReplacer("|", `\|`, "\n", " ")
+
+	for index, row := range c.Rows() {
+		cells := make([]string, len(row))
+		for cellIndex, cell := range row {
+			cells[cellIndex] = escape.Replace(cell)
+		}
+
+		if _, err := fmt.Fprintf(w, "| %s |\n", strings.Join(cells, " | ")); err != nil {
+			return err
+		}
+
+		if index == 0 {
+			separators := make([]string, len(row))
+			for cellIndex := range separators {
+				separators[cellIndex] = "---"
+			}
+			if _, err := fmt.Fprintf(w, "| %s |\n", strings.Join(separators, " | ")); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+
+	for _, line := range c.summary() {
+		if _, err := fmt.Fprintf(w, "- **%s:** %s\n", line[0], escape.Replace(line[1])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteJSON writes the comparison as indented JSON.
+func (c Comparison) WriteJSON(w io.Writer) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+func effectTypeTitle(effectType EffectType) string {
+	name := string(effectType)
+	if name == "" {
+		return name
+	}
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func joinFlavorNames(flavors []Flavor) string {
+	names := make([]string, len(flavors))
+	for index, flavor := range flavors {
+		names[index] = string(flavor)
+	}
+
+	return strings.Join(names, ", ")
+}
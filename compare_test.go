@@ -0,0 +1,118 @@
+package strainapiclient_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+func TestCompare(t *testing.T) {
+	comparison, err := strainapiclient.Compare(clienttest.NewFixtureClient(), 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(comparison.Strains) != 2 || comparison.Strains[0].Name != "Afpak" || comparison.Strains[1].Description == "" {
+		t.Errorf("Expected Afpak and Afghani with their descriptions, got %v", comparison.Strains)
+	}
+
+	if comparison.SameRace {
+		t.Error("Expected a hybrid and an indica not to have the same race")
+	}
+
+	expectedShared := []strainapiclient.Flavor{"Earthy", "Pine"}
+	if !reflect.DeepEqual(comparison.SharedFlavors, expectedShared) {
+		t.Errorf("Expected shared flavors %v, got %v", expectedShared, comparison.SharedFlavors)
+	}
+
+	expectedUnique := map[int][]strainapiclient.Flavor{1: {"Chemical"}, 2: {"Woody"}}
+	if !reflect.DeepEqual(comparison.UniqueFlavors, expectedUnique) {
+		t.Errorf("Expected unique flavors %v, got %v", expectedUnique, comparison.UniqueFlavors)
+	}
+
+	expectedNegative := strainapiclient.EffectComparison{
+		Shared: []string{"Dizzy"},
+		Unique: map[int][]string{1: {}, 2: {"Dry Mouth"}},
+	}
+	if !reflect.DeepEqual(comparison.Effects[strainapiclient.EffectTypeNegative], expectedNegative) {
+		t.Errorf("Expected negative effects %v, got %v", expectedNegative, comparison.Effects[strainapiclient.EffectTypeNegative])
+	}
+
+	if _, err := strainapiclient.Compare(clienttest.NewFixtureClient(), 1, clienttest.UnknownStrainID); !errors.Is(err, strainapiclient.ErrStrainNotFound) {
+		t.Errorf("Expected ErrStrainNotFound for an unknown ID, got %v", err)
+	}
+	if _, err := strainapiclient.Compare(clienttest.NewFixtureClient(), 1, 2, 1); !errors.Is(err, strainapiclient.ErrDuplicateStrainID) {
+		t.Errorf("Expected ErrDuplicateStrainID for an ID passed in twice, got %v", err)
+	}
+}
+
+func TestCompareListsStrainsOnce(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+
+	listed := 0
+	handler := client.SetHandleResourceRequestFunc(nil)
+	client.SetHandleResourceRequestFunc(func(resourcePath string) ([]byte, error) {
+		if strings.HasSuffix(resourcePath, "/strains/search/all") {
+			listed++
+		}
+		return handler(resourcePath)
+	})
+
+	if _, err := strainapiclient.Compare(client, 1, 2, 3, 4); err != nil {
+		t.Fatal(err)
+	}
+	if listed != 1 {
+		t.Errorf("Expected all strains to be listed once, got %d lists", listed)
+	}
+}
+
+func TestCompareRenderers(t *testing.T) {
+	dataset := clienttest.KnownDataset()
+	comparison := strainapiclient.CompareStrains(dataset.Strains[2], dataset.Strains[4], dataset.Strains[3])
+
+	var table bytes.Buffer
+	if err := comparison.WriteTable(&table); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(table.String(), "Shared flavors:") || !strings.Contains(table.String(), "Super Lemon Haze") {
+		t.Errorf("Unexpected table:\n%s", table.String())
+	}
+
+	var markdown bytes.Buffer
+	if err := comparison.WriteMarkdown(&markdown); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedLines := []string{
+		"|  | Super Lemon Haze | Sour Diesel | Blueberry |",
+		"| --- | --- | --- | --- |",
+		"| Race | sativa | sativa | indica |",
+		"| Unique flavors | Lemon | Diesel, Earthy | Berry |",
+		"- **Same race:** no",
+		"- **Shared positive:** Happy",
+	}
+	for _, expected := range expectedLines {
+		if !strings.Contains(markdown.String(), expected+"\n") {
+			t.Errorf("Expected Markdown to contain %q, got:\n%s", expected, markdown.String())
+		}
+	}
+
+	var jsonOutput bytes.Buffer
+	if err := comparison.WriteJSON(&jsonOutput); err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(jsonOutput.Bytes(), &decoded); err != nil {
+		t.Fatalf("Expected valid JSON, got %s", err)
+	}
+	if decoded["same_race"] != false || len(decoded["strains"].([]interface{})) != 3 {
+		t.Errorf("Unexpected JSON:\n%s", jsonOutput.String())
+	}
+}
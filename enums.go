@@ -0,0 +1,236 @@
+package strainapiclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrUnknownRace is returned when a value is not one of AllRaces.
+var ErrUnknownRace = errors.New("Unknown race")
+
+// ErrUnknownEffectType is returned when a value is not one of AllEffectTypes.
+var ErrUnknownEffectType = errors.New("Unknown effect type")
+
+// AllRaces returns every valid Race.
+func AllRaces() []Race {
+	return []Race{RaceIndica, RaceSativa, RaceHybrid}
+}
+
+// AllEffectTypes returns every valid EffectType.
+func AllEffectTypes() []EffectType {
+	return []EffectType{EffectTypePositive, EffectTypeNegative, EffectTypeMedical}
+}
+
+// raceAliases are the other ways a Race is written, in lower case.
+var raceAliases = map[string]Race{
+	"indicas":         RaceIndica,
+	"indica-dominant": RaceIndica,
+	"indica dominant": RaceIndica,
+	"sativas":         RaceSativa,
+	"sativa-dominant": RaceSativa,
+	"sativa dominant": RaceSativa,
+	"hybrids":         RaceHybrid,
+}
+
+// effectTypeAliases are the other ways an EffectType is written, in lower
+// case.
+var effectTypeAliases = map[string]EffectType{
+	"medicinal": EffectTypeMedical,
+}
+
+// ParseRace returns the Race for value, ignoring case and surrounding
+// spaces, and accepting aliases such as "Indica-dominant" (which is
+// RaceIndica).  It returns an error wrapping ErrUnknownRace for anything
+// else.
+func ParseRace(value string) (Race, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+
+	if race := Race(normalized); race.IsValid() {
+		return race, nil
+	}
+	if race, found := raceAliases[normalized]; found {
+		return race, nil
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrUnknownRace, value)
+}
+
+// ParseEffectType returns the EffectType for value, ignoring case and
+// surrounding spaces, and accepting aliases such as "medicinal".  It returns
+// an error wrapping ErrUnknownEffectType for anything else.
+func ParseEffectType(value string) (EffectType, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+
+	if effectType := EffectType(normalized); effectType.IsValid() {
+		return effectType, nil
+	}
+	if effectType, found := effectTypeAliases[normalized]; found {
+		return effectType, nil
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrUnknownEffectType, value)
+}
+
+// IsValid reports whether r is one of AllRaces.
+func (r Race) IsValid() bool {
+	switch r {
+	case RaceIndica, RaceSativa, RaceHybrid:
+		return true
+	}
+	return false
+}
+
+func (r Race) String() string {
+	return string(r)
+}
+
+// IsValid reports whether t is one of AllEffectTypes.
+func (t EffectType) IsValid() bool {
+	switch t {
+	case EffectTypePositive, EffectTypeNegative, EffectTypeMedical:
+		return true
+	}
+	return false
+}
+
+func (t EffectType) String() string {
+	return string(t)
+}
+
+// SetStrictEnums sets whether the DefaultClient rejects responses with a
+// Race or EffectType it does not know, and returns the previous value.  It
+// is off by default, so values the API adds later are kept as they are;
+// values that ParseRace or ParseEffectType accept are always normalized.
+// In strict mode such responses fail with an error wrapping ErrUnknownRace
+// or ErrUnknownEffectType.
+func (c *DefaultClient) SetStrictEnums(strict bool) bool {
+	current := c.strictEnums
+	c.strictEnums = strict
+	return current
+}
+
+// checkEnums returns an error for the first unknown Race or EffectType in
+// v, which may be or hold them in structs, slices and maps.  Empty values
+// are left for strict decoding to report as missing.
+func checkEnums(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			return checkEnums(v.Elem())
+		}
+	case reflect.Struct:
+		for index := 0; index < v.NumField(); index++ {
+			if err := checkEnums(v.Field(index)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for index := 0; index < v.Len(); index++ {
+			if err := checkEnums(v.Index(index)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if err := checkEnums(iter.Key()); err != nil {
+				return err
+			}
+			if err := checkEnums(iter.Value()); err != nil {
+				return err
+			}
+		}
+	case reflect.String:
+		switch v.Type() {
+		case reflect.TypeOf(Race("")):
+			if race := Race(v.String()); race != "" && !race.IsValid() {
+				return fmt.Errorf("%w: %q", ErrUnknownRace, v.String())
+			}
+		case reflect.TypeOf(EffectType("")):
+			if effectType := EffectType(v.String()); effectType != "" && !effectType.IsValid() {
+				return fmt.Errorf("%w: %q", ErrUnknownEffectType, v.String())
+			}
+		}
+	}
+
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (r Race) MarshalText() ([]byte, error) {
+	return []byte(r), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler with ParseRace.  It
+// keeps an unknown value as it is.
+func (r *Race) UnmarshalText(text []byte) error {
+	race, err := ParseRace(string(text))
+	if err != nil {
+		race = Race(text)
+	}
+
+	*r = race
+	return nil
+}
+
+// MarshalJSON marshals r as a JSON string with MarshalText.
+func (r Race) MarshalJSON() ([]byte, error) {
+	text, err := r.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals a JSON string with UnmarshalText.
+func (r *Race) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONText(data, r.UnmarshalText)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t EffectType) MarshalText() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler with ParseEffectType.
+// It keeps an unknown value as it is.
+func (t *EffectType) UnmarshalText(text []byte) error {
+	effectType, err := ParseEffectType(string(text))
+	if err != nil {
+		effectType = EffectType(text)
+	}
+
+	*t = effectType
+	return nil
+}
+
+// MarshalJSON marshals t as a JSON string with MarshalText.
+func (t EffectType) MarshalJSON() ([]byte, error) {
+	text, err := t.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON unmarshals a JSON string with UnmarshalText.
+func (t *EffectType) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONText(data, t.UnmarshalText)
+}
+
+// unmarshalJSONText unmarshals a JSON string with unmarshalText, leaving
+// the value as it is for null.
+func unmarshalJSONText(data []byte, unmarshalText func(text []byte) error) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	return unmarshalText([]byte(text))
+}
@@ -0,0 +1,117 @@
+package strainapiclient_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+func TestParseRace(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected strainapiclient.Race
+	}{
+		{"indica", strainapiclient.RaceIndica},
+		{" Sativa ", strainapiclient.RaceSativa},
+		{"HYBRID", strainapiclient.RaceHybrid},
+		{"Indica-dominant", strainapiclient.RaceIndica},
+		{"sativa dominant", strainapiclient.RaceSativa},
+	}
+
+	for _, test := range tests {
+		if race, err := strainapiclient.ParseRace(test.value); err != nil || race != test.expected {
+			t.Errorf("ParseRace(%q): expected %q, got %q, %v", test.value, test.expected, race, err)
+		}
+	}
+
+	if _, err := strainapiclient.ParseRace("ruderalis"); !errors.Is(err, strainapiclient.ErrUnknownRace) {
+		t.Errorf("Expected ErrUnknownRace, got %v", err)
+	}
+}
+
+func TestParseEffectType(t *testing.T) {
+	for _, effectType := range strainapiclient.AllEffectTypes() {
+		if parsed, err := strainapiclient.ParseEffectType(" " + effectType.String() + " "); err != nil || parsed != effectType {
+			t.Errorf("ParseEffectType(%q): got %q, %v", effectType, parsed, err)
+		}
+	}
+
+	if parsed, err := strainapiclient.ParseEffectType("Medicinal"); err != nil || parsed != strainapiclient.EffectTypeMedical {
+		t.Errorf("Expected medicinal to be medical, got %q, %v", parsed, err)
+	}
+	if _, err := strainapiclient.ParseEffectType("neutral"); !errors.Is(err, strainapiclient.ErrUnknownEffectType) {
+		t.Errorf("Expected ErrUnknownEffectType, got %v", err)
+	}
+}
+
+func TestEnumsAreValid(t *testing.T) {
+	for _, race := range strainapiclient.AllRaces() {
+		if !race.IsValid() {
+			t.Errorf("Expected %q to be valid", race)
+		}
+	}
+	if strainapiclient.Race("Indica").IsValid() {
+		t.Error("Expected IsValid to be case-sensitive; use ParseRace for input")
+	}
+	if strainapiclient.EffectType("").IsValid() {
+		t.Error("Expected the empty EffectType to be invalid")
+	}
+}
+
+func TestEnumMarshalers(t *testing.T) {
+	var strain strainapiclient.Strain
+	if err := json.Unmarshal([]byte(`{"race":"Indica","effects":{"Positive":["Happy"],"tingly":["Tingly"]}}`), &strain); err != nil {
+		t.Fatal(err)
+	}
+	if strain.Race != strainapiclient.RaceIndica || len(strain.Effects[strainapiclient.EffectTypePositive]) != 1 || len(strain.Effects["tingly"]) != 1 {
+		t.Errorf("Expected known values to be normalized and unknown ones kept, got %+v", strain)
+	}
+
+	// Marshaling keeps unknown values; only a client in strict mode rejects
+	// them.
+	if data, err := json.Marshal(strainapiclient.Strain{Race: "ruderalis"}); err != nil || !strings.Contains(string(data), `"race":"ruderalis"`) {
+		t.Errorf("Expected an unknown race to be marshaled as it is, got %s, %v", data, err)
+	}
+	if data, err := json.Marshal(struct {
+		Type strainapiclient.EffectType `json:"type"`
+	}{strainapiclient.EffectTypeMedical}); err != nil || string(data) != `{"type":"medical"}` {
+		t.Errorf("Expected a valid EffectType to be marshaled, got %s, %v", data, err)
+	}
+}
+
+func TestSetStrictEnums(t *testing.T) {
+	response := `{"Skunk":{"id":20,"race":"Ruderalis","flavors":[],"effects":{"positive":[],"negative":[],"medical":[]}}}`
+
+	strictClient := clienttest.NewFixtureClient()
+	lenientClient := clienttest.NewFixtureClient()
+	for _, client := range []*strainapiclient.DefaultClient{strictClient, lenientClient} {
+		client.SetHandleResourceRequestFunc(func(resourcePath string) ([]byte, error) {
+			return []byte(response), nil
+		})
+	}
+
+	if previous := strictClient.SetStrictEnums(true); previous {
+		t.Error("Expected strict enums to be off by default")
+	}
+
+	if _, err := strictClient.ListAllStrains(); !errors.Is(err, strainapiclient.ErrUnknownRace) {
+		t.Errorf("Expected an unknown race to be rejected in strict mode, got %v", err)
+	}
+	if strains, err := lenientClient.ListAllStrains(); err != nil || strains["Skunk"].Race != "Ruderalis" {
+		t.Errorf("Expected another client to keep the unknown race, got %+v, %v", strains, err)
+	}
+
+	response = `{"Skunk":{"id":20,"race":"Hybrid","flavors":[],"effects":{"positive":[],"tingly":[],"medical":[]}}}`
+	if _, err := strictClient.ListAllStrains(); !errors.Is(err, strainapiclient.ErrUnknownEffectType) {
+		t.Errorf("Expected an unknown effect type to be rejected in strict mode, got %v", err)
+	}
+
+	response = `{"Skunk":{"id":20,"race":"Hybrid","flavors":[],"effects":{"Positive":[],"negative":[],"medicinal":[]}}}`
+	if strains, err := strictClient.ListAllStrains(); err != nil || strains["Skunk"].Race != strainapiclient.RaceHybrid {
+		t.Errorf("Expected known values to be normalized in strict mode, got %+v, %v", strains, err)
+	}
+}
@@ -0,0 +1,53 @@
+package strainapiclient
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDescriptionNotFound is returned by GetStrainDescriptionByStrainID when the
+// API does not have a description for the strain, which is also how it
+// answers for strain IDs it does not know.
+var ErrDescriptionNotFound = errors.New("Unable to find description in result")
+
+// ErrStrainNotFound is returned when there is no strain with the ID
+// being looked up.
+var ErrStrainNotFound = errors.New("Strain not found")
+
+// ErrAmbiguousStrainName is returned by a StrainResolver for a name that
+// more than one strain has once case and spacing are ignored.
+var ErrAmbiguousStrainName = errors.New("More than one strain has that name")
+
+// ErrDuplicateStrainID is returned by Compare when a strain ID is passed in
+// more than once.
+var ErrDuplicateStrainID = errors.New("Strain ID passed in more than once")
+
+// ErrCircuitOpen is returned, without calling the API, for requests made
+// while a CircuitBreaker is open and there is no fallback for them.
+var ErrCircuitOpen = errors.New("The circuit breaker is open, not calling the api")
+
+// StatusError is returned when the API answers with a status other
+// than 200 OK.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("Status: %d - %s", e.StatusCode, e.Body)
+}
+
+// ConnectionError is returned when the API could not be reached or
+// its response could not be read.
+type ConnectionError struct {
+	Err error
+}
+
+func (e *ConnectionError) Error() string {
+	return fmt.Sprintf("There was a problem connecting to the api: %s", e.Err)
+}
+
+// Unwrap returns the underlying network error.
+func (e *ConnectionError) Unwrap() error {
+	return e.Err
+}
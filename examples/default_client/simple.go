@@ -5,7 +5,6 @@ import (
 	"log"
 	"os"
 	"reflect"
-	"strings"
 
 	"github.com/tchype/strainapiclient-go"
 )
@@ -22,7 +21,7 @@ func main() {
 	fmt.Println("Connecting to API...")
 	canConnect := client.CanConnect()
 	if !canConnect {
-		log.Fatalf("Unable to connect to the API with apiKey: '%s'", apiKey)
+		log.Fatalf("Unable to connect to the API with the apiKey from '%s'", apiEnvironmentVariableName)
 	}
 
 	const strainID int = 1
@@ -50,10 +49,14 @@ func main() {
 	fmt.Println("Got error", mockErr)
 
 	const mockStrainID int = 1234567890
-	fmt.Println("\nOverriding the HandleResourceRequestFunc, but only for a specific GetFlavorsByStrainID call with a specific Strain ID:", mockStrainID, "...")
+	fmt.Println("\nStubbing only the GetFlavorsByStrainID call with a specific Strain ID:", mockStrainID, "...")
 
-	originalResourceHandler = wrapDefaultResourceHandlerForFlavorsByStrainIDRequest(client, mockStrainID)
-	defer client.SetHandleResourceRequestFunc(originalResourceHandler)
+	// Middlewares added with Use wrap the handler, so the requests that are
+	// not stubbed carry on to the API.
+	client.Use(strainapiclient.StubMiddleware(strainapiclient.Stub{
+		Path: fmt.Sprintf("/strains/data/flavors/%d", mockStrainID),
+		Body: []byte("[\"Snot\", \"Tar\", \"Unrealized Dreams\"]"),
+	}))
 
 	// Since we are mocking the GetStrainFlavorsByStrainID call, we can
 	// predict what it's going to be.
@@ -96,24 +99,3 @@ func main() {
 func alwaysReturnErrorRegardlessOfResourcePath(resourcePath string) ([]byte, error) {
 	return make([]byte, 0), fmt.Errorf("Always returning an error; resource path: %s", resourcePath)
 }
-
-// Wrap the current HandleResourceRequestFunc with this one that returns a mock response for
-// flavors by strain id with a specific strain id; the rest of the calls will use the previous HandleResourceRequestFunc implementation.
-func wrapDefaultResourceHandlerForFlavorsByStrainIDRequest(client strainapiclient.Client, strainID int) strainapiclient.HandleResourceRequestFunc {
-
-	originalFunc := client.SetHandleResourceRequestFunc(nil)
-
-	var wrapperFuncForStrainFlavor strainapiclient.HandleResourceRequestFunc
-	wrapperFuncForStrainFlavor = func(resourcePath string) ([]byte, error) {
-		if strings.Contains(resourcePath, fmt.Sprintf("/strains/data/flavors/%d", strainID)) {
-			returnValue := []byte("[\"Snot\", \"Tar\", \"Unrealized Dreams\"]")
-			return returnValue, nil
-		}
-
-		return originalFunc(resourcePath)
-	}
-
-	_ = client.SetHandleResourceRequestFunc(wrapperFuncForStrainFlavor)
-
-	return originalFunc
-}
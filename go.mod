@@ -3,7 +3,12 @@ module github.com/tchype/strainapiclient-go
 go 1.14
 
 require (
-	github.com/google/go-cmp v0.5.0
+	github.com/google/go-cmp v0.5.5
+	github.com/graphql-go/graphql v0.8.1
+	golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9
+	golang.org/x/text v0.3.0
+	google.golang.org/grpc v1.41.0
+	google.golang.org/protobuf v1.27.1
 )
 
 replace github.com/tchype/strainapiclient-go => ./
@@ -0,0 +1,118 @@
+// Package graphqlapi is a GraphQL gateway to a strainapiclient.Client, so a
+// caller can fetch exactly the fields it needs in one round trip:
+//
+//	{
+//	  searchStrains(race: INDICA, flavor: "Earthy") {
+//	    name
+//	    description
+//	    effects(type: POSITIVE) { name }
+//	  }
+//	}
+//
+// The schema models Strain, Effect (with EffectType), Flavor and Race, with
+// the queries strain(id), strains(ids), searchStrains(name, race, flavor,
+// effect), effects(type) and flavors.  The fields of a strain that the
+// search results do not include are resolved with batched loaders, so a list
+// of 100 strains makes its 100 GetStrainEffectsByStrainID calls concurrently
+// (a few at a time) instead of one after another, and never twice for the
+// same strain in a query.
+package graphqlapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+	"github.com/tchype/strainapiclient-go"
+)
+
+// Request is a GraphQL request, as sent in the body of a POST.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// Gateway executes GraphQL queries against a Client.  It is also an
+// http.Handler.
+type Gateway struct {
+	client strainapiclient.Client
+	schema graphql.Schema
+}
+
+// NewGateway creates a Gateway whose resolvers call client.
+func NewGateway(client strainapiclient.Client) (*Gateway, error) {
+	schema, err := newSchema(client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Gateway{client: client, schema: schema}, nil
+}
+
+// Schema returns the GraphQL schema of the Gateway.
+func (g *Gateway) Schema() graphql.Schema {
+	return g.schema
+}
+
+// Execute runs a GraphQL request with its own set of loaders.
+func (g *Gateway) Execute(ctx context.Context, request Request) *graphql.Result {
+	return graphql.Do(graphql.Params{
+		Schema:         g.schema,
+		RequestString:  request.Query,
+		OperationName:  request.OperationName,
+		VariableValues: request.Variables,
+		Context:        withLoaders(ctx, g.client),
+	})
+}
+
+// ServeHTTP accepts a query in the query string of a GET (query,
+// operationName and variables as JSON) or as a JSON Request in the body of a
+// POST.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var request Request
+
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		request.Query = query.Get("query")
+		request.OperationName = query.Get("operationName")
+		if variables := query.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+				writeErrorResult(w, http.StatusBadRequest, "variables must be a JSON object")
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			writeErrorResult(w, http.StatusBadRequest, "The body must be a JSON object with a query")
+			return
+		}
+	default:
+		w.Header().Set("Allow", http.MethodGet+", "+http.MethodPost)
+		writeErrorResult(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+		return
+	}
+
+	if request.Query == "" {
+		writeErrorResult(w, http.StatusBadRequest, "A query is required")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, g.Execute(r.Context(), request))
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// writeErrorResult writes a request the gateway could not execute in the
+// shape of a GraphQL result, which is what clients expect.
+func writeErrorResult(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"errors": []map[string]string{{"message": message}},
+	})
+}
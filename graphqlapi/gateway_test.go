@@ -0,0 +1,287 @@
+package graphqlapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+// countingClient is a fixture client that counts the calls made to it.  When
+// barrier is set, GetStrainEffectsByStrainID calls wait until that many are
+// in flight, so they fail unless the loader makes them concurrently.
+type countingClient struct {
+	strainapiclient.Client
+	barrier int
+
+	mutex    sync.Mutex
+	calls    map[string]int
+	inFlight int
+	arrived  chan struct{}
+}
+
+func newCountingClient(barrier int) *countingClient {
+	return &countingClient{
+		Client:  clienttest.NewFixtureClient(),
+		barrier: barrier,
+		calls:   make(map[string]int),
+		arrived: make(chan struct{}),
+	}
+}
+
+func (c *countingClient) count(call string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.calls[call]++
+}
+
+func (c *countingClient) callCount(call string) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.calls[call]
+}
+
+func (c *countingClient) ListAllStrains() (strainapiclient.ListAllStrainsResult, error) {
+	c.count("ListAllStrains")
+	return c.Client.ListAllStrains()
+}
+
+func (c *countingClient) GetStrainDescriptionByStrainID(id int) (string, error) {
+	c.count("GetStrainDescriptionByStrainID")
+	return c.Client.GetStrainDescriptionByStrainID(id)
+}
+
+func (c *countingClient) GetStrainEffectsByStrainID(id int) (strainapiclient.EffectsByEffectType, error) {
+	c.count("GetStrainEffectsByStrainID")
+
+	if c.barrier > 0 {
+		c.mutex.Lock()
+		c.inFlight++
+		if c.inFlight == c.barrier {
+			close(c.arrived)
+		}
+		c.mutex.Unlock()
+
+		select {
+		case <-c.arrived:
+		case <-time.After(2 * time.Second):
+			return nil, errors.New("the effects were not requested concurrently")
+		}
+	}
+
+	return c.Client.GetStrainEffectsByStrainID(id)
+}
+
+func execute(t *testing.T, client strainapiclient.Client, query string) map[string]interface{} {
+	gateway, err := NewGateway(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := gateway.Execute(context.Background(), Request{Query: query})
+	if result.HasErrors() {
+		t.Fatalf("Expected no errors, got %v", result.Errors)
+	}
+
+	encoded, _ := json.Marshal(result.Data)
+	data := make(map[string]interface{})
+	_ = json.Unmarshal(encoded, &data)
+	return data
+}
+
+func TestSearchStrainsResolvesNestedFields(t *testing.T) {
+	data := execute(t, clienttest.NewFixtureClient(), `{
+		searchStrains(race: INDICA, flavor: "Berry") {
+			id
+			name
+			race
+			description
+			flavors { name }
+			effects(type: NEGATIVE) { name type }
+		}
+	}`)
+
+	expected := map[string]interface{}{
+		"searchStrains": []interface{}{
+			map[string]interface{}{
+				"id":          float64(4),
+				"name":        "Blueberry",
+				"race":        "INDICA",
+				"description": "Blueberry is an indica known for its sweet berry aroma and long-lasting calm.",
+				"flavors":     []interface{}{map[string]interface{}{"name": "Berry"}, map[string]interface{}{"name": "Sweet"}},
+				"effects":     []interface{}{map[string]interface{}{"name": "Dry Mouth", "type": "NEGATIVE"}},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(expected, data); diff != "" {
+		t.Errorf("Unexpected result (-expected +actual):\n%s", diff)
+	}
+}
+
+func TestNestedFieldsAreLoadedInBatches(t *testing.T) {
+	client := newCountingClient(5)
+
+	// Every strain has the Happy effect, and the second search repeats two
+	// of the strains, which must not be requested again.
+	data := execute(t, client, `{
+		happy: searchStrains(effect: "Happy") { name effects { name } }
+		indica: searchStrains(race: INDICA) { name effects { name } description }
+	}`)
+
+	if strains := data["happy"].([]interface{}); len(strains) != 5 {
+		t.Fatalf("Expected all five strains to be Happy, got %v", strains)
+	}
+
+	if calls := client.callCount("GetStrainEffectsByStrainID"); calls != 5 {
+		t.Errorf("Expected the effects of each strain to be requested once, got %d calls", calls)
+	}
+	if calls := client.callCount("GetStrainDescriptionByStrainID"); calls != 2 {
+		t.Errorf("Expected the descriptions of the two indica strains to be requested, got %d calls", calls)
+	}
+}
+
+func TestStrainLookups(t *testing.T) {
+	client := newCountingClient(0)
+
+	data := execute(t, client, `{
+		strains(ids: [3, 999999, 1]) { name flavors { name } effects(type: POSITIVE) { name } }
+		unknown: strain(id: 999999) { name }
+		afpak: strain(id: 1) { name race }
+	}`)
+
+	expected := map[string]interface{}{
+		"strains": []interface{}{
+			map[string]interface{}{
+				"name":    "Super Lemon Haze",
+				"flavors": []interface{}{map[string]interface{}{"name": "Lemon"}, map[string]interface{}{"name": "Citrus"}, map[string]interface{}{"name": "Sweet"}},
+				"effects": []interface{}{
+					map[string]interface{}{"name": "Energetic"}, map[string]interface{}{"name": "Happy"},
+					map[string]interface{}{"name": "Euphoric"}, map[string]interface{}{"name": "Creative"},
+				},
+			},
+			nil,
+			map[string]interface{}{
+				"name":    "Afpak",
+				"flavors": []interface{}{map[string]interface{}{"name": "Earthy"}, map[string]interface{}{"name": "Chemical"}, map[string]interface{}{"name": "Pine"}},
+				"effects": []interface{}{
+					map[string]interface{}{"name": "Relaxed"}, map[string]interface{}{"name": "Hungry"},
+					map[string]interface{}{"name": "Happy"}, map[string]interface{}{"name": "Sleepy"},
+				},
+			},
+		},
+		"unknown": nil,
+		"afpak":   map[string]interface{}{"name": "Afpak", "race": "HYBRID"},
+	}
+
+	if diff := cmp.Diff(expected, data); diff != "" {
+		t.Errorf("Unexpected result (-expected +actual):\n%s", diff)
+	}
+
+	if calls := client.callCount("ListAllStrains"); calls != 1 {
+		t.Errorf("Expected every lookup to share one ListAllStrains call, got %d", calls)
+	}
+}
+
+func TestCatalogQueries(t *testing.T) {
+	data := execute(t, clienttest.NewFixtureClient(), `{
+		effects(type: NEGATIVE) { name }
+		flavors { name }
+	}`)
+
+	if effects := data["effects"].([]interface{}); len(effects) != 3 {
+		t.Errorf("Expected the three negative effects, got %v", effects)
+	}
+	if flavors := data["flavors"].([]interface{}); len(flavors) != len(clienttest.KnownDataset().Flavors) {
+		t.Errorf("Expected every flavor, got %v", flavors)
+	}
+}
+
+func TestSearchStrainsNeedsCriteria(t *testing.T) {
+	gateway, err := NewGateway(clienttest.NewFixtureClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := gateway.Execute(context.Background(), Request{Query: `{ searchStrains { name } }`})
+	if len(result.Errors) != 1 || result.Errors[0].Message != errNoSearchCriteria.Error() {
+		t.Errorf("Expected the missing criteria to be reported, got %v", result.Errors)
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	gateway, err := NewGateway(clienttest.NewFixtureClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(gateway)
+	defer server.Close()
+
+	query := `query Lookup($id: Int!) { strain(id: $id) { name } }`
+	expected := `{"data":{"strain":{"name":"Afghani"}}}`
+
+	body := `{"query":` + mustMarshal(query) + `,"variables":{"id":2}}`
+	requests := []*http.Request{
+		mustRequest(http.MethodPost, server.URL, body),
+		mustRequest(http.MethodGet, server.URL+"?query="+url.QueryEscape(query)+"&variables="+url.QueryEscape(`{"id":2}`), ""),
+	}
+
+	for _, request := range requests {
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var actual json.RawMessage
+		_ = json.NewDecoder(response.Body).Decode(&actual)
+		response.Body.Close()
+
+		if response.StatusCode != http.StatusOK || string(actual) != expected {
+			t.Errorf("%s: expected %s, got %d %s", request.Method, expected, response.StatusCode, actual)
+		}
+	}
+
+	badRequests := []struct {
+		request  *http.Request
+		expected int
+	}{
+		{mustRequest(http.MethodPost, server.URL, "not json"), http.StatusBadRequest},
+		{mustRequest(http.MethodGet, server.URL, ""), http.StatusBadRequest},
+		{mustRequest(http.MethodDelete, server.URL, ""), http.StatusMethodNotAllowed},
+	}
+
+	for _, test := range badRequests {
+		response, err := http.DefaultClient.Do(test.request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+
+		if response.StatusCode != test.expected {
+			t.Errorf("%s %s: expected status %d, got %d", test.request.Method, test.request.URL, test.expected, response.StatusCode)
+		}
+	}
+}
+
+func mustMarshal(value interface{}) string {
+	encoded, _ := json.Marshal(value)
+	return string(encoded)
+}
+
+func mustRequest(method string, target string, body string) *http.Request {
+	request, err := http.NewRequest(method, target, strings.NewReader(body))
+	if err != nil {
+		panic(err)
+	}
+	return request
+}
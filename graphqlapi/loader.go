@@ -0,0 +1,124 @@
+package graphqlapi
+
+import (
+	"sync"
+)
+
+// maxConcurrentRequests limits how many requests a batch makes to the Client
+// at once.
+const maxConcurrentRequests int = 8
+
+// loadResult is what a loader knows about one strain ID.
+type loadResult struct {
+	value interface{}
+	err   error
+}
+
+// batchFunc loads the values for a batch of strain IDs.
+type batchFunc func(ids []int) map[int]loadResult
+
+// loader collects the strain IDs requested while a query is resolved and
+// loads them in a single batch the first time one of the values is needed.
+//
+// The executor resolves all the fields at one depth of the query before it
+// calls any of the thunks returned by load, so a list of strains resolving a
+// field for each strain produces one batch rather than one request per strain.
+// A loader caches its values, so it should only live as long as a query.
+type loader struct {
+	batch batchFunc
+
+	mutex   sync.Mutex
+	pending []int
+	queued  map[int]bool
+	results map[int]loadResult
+
+	dispatchMutex sync.Mutex
+}
+
+func newLoader(batch batchFunc) *loader {
+	return &loader{
+		batch:   batch,
+		queued:  make(map[int]bool),
+		results: make(map[int]loadResult),
+	}
+}
+
+// load queues an ID and returns a thunk that resolves to its value.
+func (l *loader) load(id int) func() (interface{}, error) {
+	l.mutex.Lock()
+	if _, loaded := l.results[id]; !loaded && !l.queued[id] {
+		l.queued[id] = true
+		l.pending = append(l.pending, id)
+	}
+	l.mutex.Unlock()
+
+	return func() (interface{}, error) {
+		l.dispatch()
+
+		l.mutex.Lock()
+		defer l.mutex.Unlock()
+		result := l.results[id]
+		return result.value, result.err
+	}
+}
+
+// dispatch loads every queued ID.
+func (l *loader) dispatch() {
+	l.dispatchMutex.Lock()
+	defer l.dispatchMutex.Unlock()
+
+	l.mutex.Lock()
+	ids := l.pending
+	l.pending = nil
+	l.mutex.Unlock()
+
+	if len(ids) == 0 {
+		return
+	}
+
+	results := l.batch(ids)
+
+	l.mutex.Lock()
+	for _, id := range ids {
+		l.results[id] = results[id]
+		delete(l.queued, id)
+	}
+	l.mutex.Unlock()
+}
+
+// fetchEach calls fetch for every ID with at most maxConcurrentRequests
+// calls in flight, for Client methods that only take one ID.
+func fetchEach(ids []int, fetch func(id int) (interface{}, error)) map[int]loadResult {
+	results := make(map[int]loadResult, len(ids))
+	var mutex sync.Mutex
+
+	work := make(chan int)
+	var wg sync.WaitGroup
+
+	workers := maxConcurrentRequests
+	if len(ids) < workers {
+		workers = len(ids)
+	}
+
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range work {
+				value, err := fetch(id)
+
+				mutex.Lock()
+				results[id] = loadResult{value: value, err: err}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for _, id := range ids {
+		work <- id
+	}
+	close(work)
+	wg.Wait()
+
+	return results
+}
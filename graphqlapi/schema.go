@@ -0,0 +1,436 @@
+package graphqlapi
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/graphql-go/graphql"
+	"github.com/tchype/strainapiclient-go"
+)
+
+// strainSource is the value behind a Strain in the schema.  The searches
+// return different subsets of a strain's fields, so the ones that are not
+// known yet are nil and resolved with the loaders.
+type strainSource struct {
+	ID          int
+	Name        string
+	Race        strainapiclient.Race
+	description *string
+	flavors     []strainapiclient.Flavor
+	effects     strainapiclient.EffectsByEffectType
+}
+
+func sourceFromStrain(strain strainapiclient.Strain) *strainSource {
+	effects := make(strainapiclient.EffectsByEffectType)
+	for effectType, names := range strain.Effects {
+		for _, name := range names {
+			effects[effectType] = append(effects[effectType], strainapiclient.Effect{Name: name, Type: effectType})
+		}
+	}
+
+	flavors := strain.Flavors
+	if flavors == nil {
+		flavors = make([]strainapiclient.Flavor, 0)
+	}
+
+	return &strainSource{ID: strain.ID, Name: strain.Name, Race: strain.Race, flavors: flavors, effects: effects}
+}
+
+// loaders are the batched loaders of one query.
+type loaders struct {
+	strains      *loader
+	descriptions *loader
+	flavors      *loader
+	effects      *loader
+}
+
+func newLoaders(client strainapiclient.Client) *loaders {
+	return &loaders{
+		// The upstream API can only look strains up all at once.
+		strains: newLoader(func(ids []int) map[int]loadResult {
+			results := make(map[int]loadResult, len(ids))
+
+			allStrains, err := client.ListAllStrains()
+			if err != nil {
+				for _, id := range ids {
+					results[id] = loadResult{err: err}
+				}
+				return results
+			}
+
+			for _, strain := range allStrains {
+				results[strain.ID] = loadResult{value: sourceFromStrain(strain)}
+			}
+			return results
+		}),
+
+		descriptions: newLoader(func(ids []int) map[int]loadResult {
+			return fetchEach(ids, func(id int) (interface{}, error) {
+				description, err := client.GetStrainDescriptionByStrainID(id)
+				if errors.Is(err, strainapiclient.ErrDescriptionNotFound) {
+					return nil, nil
+				}
+				return description, err
+			})
+		}),
+
+		flavors: newLoader(func(ids []int) map[int]loadResult {
+			return fetchEach(ids, func(id int) (interface{}, error) {
+				return client.GetStrainFlavorsByStrainID(id)
+			})
+		}),
+
+		effects: newLoader(func(ids []int) map[int]loadResult {
+			return fetchEach(ids, func(id int) (interface{}, error) {
+				return client.GetStrainEffectsByStrainID(id)
+			})
+		}),
+	}
+}
+
+type loadersContextKey struct{}
+
+// withLoaders returns a context carrying new loaders for a query.
+func withLoaders(ctx context.Context, client strainapiclient.Client) context.Context {
+	return context.WithValue(ctx, loadersContextKey{}, newLoaders(client))
+}
+
+// loadersFrom returns the loaders of the query, or new ones, which cannot
+// batch across fields, when the query was not started by a Gateway.
+func loadersFrom(ctx context.Context, client strainapiclient.Client) *loaders {
+	if ctx != nil {
+		if l, ok := ctx.Value(loadersContextKey{}).(*loaders); ok {
+			return l
+		}
+	}
+	return newLoaders(client)
+}
+
+// effectTypeOrder is the order a strain's effects are listed in.
+var effectTypeOrder = []strainapiclient.EffectType{
+	strainapiclient.EffectTypePositive, strainapiclient.EffectTypeNegative, strainapiclient.EffectTypeMedical,
+}
+
+// effectList flattens the effects of a strain, keeping only effectType
+// when it is not empty.
+func effectList(effects strainapiclient.EffectsByEffectType, effectType string) []strainapiclient.Effect {
+	effectTypes := append([]strainapiclient.EffectType{}, effectTypeOrder...)
+	others := make([]string, 0)
+	for key := range effects {
+		if key != strainapiclient.EffectTypePositive && key != strainapiclient.EffectTypeNegative && key != strainapiclient.EffectTypeMedical {
+			others = append(others, string(key))
+		}
+	}
+	sort.Strings(others)
+	for _, other := range others {
+		effectTypes = append(effectTypes, strainapiclient.EffectType(other))
+	}
+
+	result := make([]strainapiclient.Effect, 0)
+	for _, key := range effectTypes {
+		if effectType == "" || string(key) == effectType {
+			result = append(result, effects[key]...)
+		}
+	}
+	return result
+}
+
+func newSchema(client strainapiclient.Client) (graphql.Schema, error) {
+	raceEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name:        "Race",
+		Description: "The type of a strain.",
+		Values: graphql.EnumValueConfigMap{
+			"INDICA": {Value: string(strainapiclient.RaceIndica)},
+			"SATIVA": {Value: string(strainapiclient.RaceSativa)},
+			"HYBRID": {Value: string(strainapiclient.RaceHybrid)},
+		},
+	})
+
+	effectTypeEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name:        "EffectType",
+		Description: "The kind of an effect.",
+		Values: graphql.EnumValueConfigMap{
+			"POSITIVE": {Value: string(strainapiclient.EffectTypePositive)},
+			"NEGATIVE": {Value: string(strainapiclient.EffectTypeNegative)},
+			"MEDICAL":  {Value: string(strainapiclient.EffectTypeMedical)},
+		},
+	})
+
+	effectType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Effect",
+		Description: "An effect that can be experienced when consuming a strain.",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(strainapiclient.Effect).Name, nil
+				},
+			},
+			"type": &graphql.Field{
+				Type: graphql.NewNonNull(effectTypeEnum),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return string(p.Source.(strainapiclient.Effect).Type), nil
+				},
+			},
+		},
+	})
+
+	flavorType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Flavor",
+		Description: "A component of the flavor of a strain.",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return string(p.Source.(strainapiclient.Flavor)), nil
+				},
+			},
+		},
+	})
+
+	strainType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Strain",
+		Description: "A strain of cannabis.",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*strainSource).ID, nil
+				},
+			},
+			"name": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*strainSource).Name, nil
+				},
+			},
+			"race": &graphql.Field{
+				Type: raceEnum,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return string(p.Source.(*strainSource).Race), nil
+				},
+			},
+			"description": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					source := p.Source.(*strainSource)
+					if source.description != nil {
+						return *source.description, nil
+					}
+					return loadersFrom(p.Context, client).descriptions.load(source.ID), nil
+				},
+			},
+			"flavors": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(flavorType))),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					source := p.Source.(*strainSource)
+					if source.flavors != nil {
+						return source.flavors, nil
+					}
+					return loadersFrom(p.Context, client).flavors.load(source.ID), nil
+				},
+			},
+			"effects": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(effectType))),
+				Args: graphql.FieldConfigArgument{
+					"type": &graphql.ArgumentConfig{Type: effectTypeEnum, Description: "Only list effects of this type."},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					source := p.Source.(*strainSource)
+					onlyType, _ := p.Args["type"].(string)
+					if source.effects != nil {
+						return effectList(source.effects, onlyType), nil
+					}
+
+					thunk := loadersFrom(p.Context, client).effects.load(source.ID)
+					return func() (interface{}, error) {
+						value, err := thunk()
+						if err != nil {
+							return nil, err
+						}
+						effects, _ := value.(strainapiclient.EffectsByEffectType)
+						return effectList(effects, onlyType), nil
+					}, nil
+				},
+			},
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"strain": &graphql.Field{
+				Type:        strainType,
+				Description: "The strain with an ID, or null if there is none.",
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return loadersFrom(p.Context, client).strains.load(p.Args["id"].(int)), nil
+				},
+			},
+			"strains": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(strainType)),
+				Description: "The strains with the IDs, with null for the IDs there is no strain for.",
+				Args: graphql.FieldConfigArgument{
+					"ids": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.Int)))},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					strainsLoader := loadersFrom(p.Context, client).strains
+
+					ids, _ := p.Args["ids"].([]interface{})
+					thunks := make([]func() (interface{}, error), len(ids))
+					for index, id := range ids {
+						thunks[index] = strainsLoader.load(id.(int))
+					}
+
+					return func() (interface{}, error) {
+						strains := make([]interface{}, len(thunks))
+						for index, thunk := range thunks {
+							strain, err := thunk()
+							if err != nil {
+								return nil, err
+							}
+							strains[index] = strain
+						}
+						return strains, nil
+					}, nil
+				},
+			},
+			"searchStrains": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(strainType))),
+				Description: "The strains matching every one of the criteria given; at least one is required.",
+				Args: graphql.FieldConfigArgument{
+					"name":   &graphql.ArgumentConfig{Type: graphql.String, Description: "Text the strain name contains."},
+					"race":   &graphql.ArgumentConfig{Type: raceEnum},
+					"flavor": &graphql.ArgumentConfig{Type: graphql.String},
+					"effect": &graphql.ArgumentConfig{Type: graphql.String, Description: "The name of an effect."},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return searchStrains(client, p.Args)
+				},
+			},
+			"effects": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(effectType))),
+				Description: "All the effects strains can have.",
+				Args: graphql.FieldConfigArgument{
+					"type": &graphql.ArgumentConfig{Type: effectTypeEnum, Description: "Only list effects of this type."},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					effects, err := client.ListAllEffects()
+					if err != nil {
+						return nil, err
+					}
+
+					onlyType, _ := p.Args["type"].(string)
+					result := make([]strainapiclient.Effect, 0, len(effects))
+					for _, effect := range effects {
+						if onlyType == "" || string(effect.Type) == onlyType {
+							result = append(result, effect)
+						}
+					}
+					return result, nil
+				},
+			},
+			"flavors": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(flavorType))),
+				Description: "All the flavors strains can have.",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return client.ListAllFlavors()
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+// errNoSearchCriteria is returned for a searchStrains query without any
+// criteria.
+var errNoSearchCriteria = errors.New("searchStrains needs at least one of name, race, flavor or effect")
+
+// searchStrains runs a Client search for every criterion given and returns
+// the strains in all of the results, in the order of the first one.
+func searchStrains(client strainapiclient.Client, args map[string]interface{}) ([]*strainSource, error) {
+	var matches []*strainSource
+
+	addResults := func(results []*strainSource) {
+		if matches == nil {
+			matches = results
+			return
+		}
+
+		found := make(map[int]bool, len(results))
+		for _, result := range results {
+			found[result.ID] = true
+		}
+
+		remaining := make([]*strainSource, 0, len(matches))
+		for _, match := range matches {
+			if found[match.ID] {
+				remaining = append(remaining, match)
+			}
+		}
+		matches = remaining
+	}
+
+	if name, ok := args["name"].(string); ok {
+		results, err := client.SearchStrainsByName(name)
+		if err != nil {
+			return nil, err
+		}
+
+		sources := make([]*strainSource, len(results))
+		for index, result := range results {
+			description := result.Description
+			sources[index] = &strainSource{ID: result.ID, Name: result.Name, Race: result.Race, description: &description}
+		}
+		addResults(sources)
+	}
+
+	if race, ok := args["race"].(string); ok {
+		results, err := client.SearchStrainsByRace(strainapiclient.Race(race))
+		if err != nil {
+			return nil, err
+		}
+
+		sources := make([]*strainSource, len(results))
+		for index, result := range results {
+			sources[index] = &strainSource{ID: result.ID, Name: result.Name, Race: result.Race}
+		}
+		addResults(sources)
+	}
+
+	if flavor, ok := args["flavor"].(string); ok {
+		results, err := client.SearchStrainsByFlavor(strainapiclient.Flavor(flavor))
+		if err != nil {
+			return nil, err
+		}
+
+		sources := make([]*strainSource, len(results))
+		for index, result := range results {
+			sources[index] = &strainSource{ID: result.ID, Name: result.Name, Race: result.Race}
+		}
+		addResults(sources)
+	}
+
+	if effect, ok := args["effect"].(string); ok {
+		results, err := client.SearchStrainsByEffectName(effect)
+		if err != nil {
+			return nil, err
+		}
+
+		sources := make([]*strainSource, len(results))
+		for index, result := range results {
+			sources[index] = &strainSource{ID: result.ID, Name: result.Name, Race: result.Race}
+		}
+		addResults(sources)
+	}
+
+	if matches == nil {
+		return nil, errNoSearchCriteria
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,281 @@
+package grpcapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/tchype/strainapiclient-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultTimeout is how long a call waits for the server unless changed
+// with SetTimeout.
+const defaultTimeout time.Duration = 30 * time.Second
+
+// Client is a strainapiclient.Client that calls a StrainService over gRPC.
+// Errors from the server are mapped back to the errors a DefaultClient
+// returns: ErrDescriptionNotFound, *StatusError and *ConnectionError.
+type Client struct {
+	service                    StrainServiceClient
+	timeout                    time.Duration
+	resourceRequestHandlerFunc strainapiclient.HandleResourceRequestFunc
+}
+
+// NewClient creates a Client that makes its calls on conn:
+//
+//	conn, err := grpc.Dial("strainserver:9090", grpc.WithInsecure())
+//	client := grpcapi.NewClient(conn)
+func NewClient(conn grpc.ClientConnInterface) *Client {
+	return &Client{service: NewStrainServiceClient(conn), timeout: defaultTimeout}
+}
+
+// SetTimeout sets how long each call waits for the server and returns the
+// previous value.
+func (c *Client) SetTimeout(timeout time.Duration) time.Duration {
+	previous := c.timeout
+	c.timeout = timeout
+	return previous
+}
+
+// SetHandleResourceRequestFunc is part of the strainapiclient.Client
+// interface.  It returns the function set before, but a Client makes RPCs
+// rather than resource requests, so the function is never called: wrapping
+// it, as with a ResponseCache, has no effect.  Add middleware to the Client
+// the server uses instead.
+func (c *Client) SetHandleResourceRequestFunc(f strainapiclient.HandleResourceRequestFunc) strainapiclient.HandleResourceRequestFunc {
+	previous := c.resourceRequestHandlerFunc
+	c.resourceRequestHandlerFunc = f
+	return previous
+}
+
+func (c *Client) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), c.timeout)
+}
+
+// ListAllEffects returns all effects that can be experienced.
+func (c *Client) ListAllEffects() ([]strainapiclient.Effect, error) {
+	effects := make([]strainapiclient.Effect, 0)
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	response, err := c.service.ListAllEffects(ctx, &ListAllEffectsRequest{})
+	if err != nil {
+		return effects, errorFromStatus(err)
+	}
+
+	for _, effect := range response.GetEffects() {
+		effects = append(effects, effectFromProto(effect))
+	}
+	return effects, nil
+}
+
+// ListAllFlavors returns all flavors of strains.
+func (c *Client) ListAllFlavors() ([]strainapiclient.Flavor, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	response, err := c.service.ListAllFlavors(ctx, &ListAllFlavorsRequest{})
+	if err != nil {
+		return make([]strainapiclient.Flavor, 0), errorFromStatus(err)
+	}
+
+	return flavorsFromProto(response.GetFlavors()), nil
+}
+
+// ListAllStrains returns all strains, keyed by name.
+func (c *Client) ListAllStrains() (strainapiclient.ListAllStrainsResult, error) {
+	strains := make(strainapiclient.ListAllStrainsResult)
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	response, err := c.service.ListAllStrains(ctx, &ListAllStrainsRequest{})
+	if err != nil {
+		return strains, errorFromStatus(err)
+	}
+
+	for _, strain := range response.GetStrains() {
+		strains[strain.GetName()] = strainFromProto(strain)
+	}
+	return strains, nil
+}
+
+// StreamAllStrains calls each with every strain, in order of ID, as the
+// server sends them, stopping at the first error each returns.  Unlike the
+// other calls it is not limited by the timeout; cancel ctx to stop early.
+func (c *Client) StreamAllStrains(ctx context.Context, each func(strainapiclient.Strain) error) error {
+	stream, err := c.service.StreamAllStrains(ctx, &StreamAllStrainsRequest{})
+	if err != nil {
+		return errorFromStatus(err)
+	}
+
+	for {
+		strain, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errorFromStatus(err)
+		}
+
+		if err := each(strainFromProto(strain)); err != nil {
+			return err
+		}
+	}
+}
+
+// SearchStrainsByName returns the strains matching the name passed in.
+func (c *Client) SearchStrainsByName(name string) (strainapiclient.SearchStrainsByNameResults, error) {
+	results := make(strainapiclient.SearchStrainsByNameResults, 0)
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	response, err := c.service.SearchStrainsByName(ctx, &SearchStrainsByNameRequest{Name: name})
+	if err != nil {
+		return results, errorFromStatus(err)
+	}
+
+	for _, result := range response.GetResults() {
+		results = append(results, strainapiclient.SearchStrainsByNameResult{
+			Name: result.GetName(), ID: int(result.GetId()), Description: result.GetDescription(), Race: raceFromProto(result.GetRace()),
+		})
+	}
+	return results, nil
+}
+
+// SearchStrainsByRace returns the strains of the Race passed in.  Races
+// the service does not define have no strains.
+func (c *Client) SearchStrainsByRace(race strainapiclient.Race) (strainapiclient.SearchStrainsByRaceResults, error) {
+	results := make(strainapiclient.SearchStrainsByRaceResults, 0)
+
+	protoRace := raceToProto(race)
+	if protoRace == Race_RACE_UNSPECIFIED {
+		return results, nil
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	response, err := c.service.SearchStrainsByRace(ctx, &SearchStrainsByRaceRequest{Race: protoRace})
+	if err != nil {
+		return results, errorFromStatus(err)
+	}
+
+	for _, result := range response.GetResults() {
+		results = append(results, strainapiclient.SearchStrainsByRaceResult{
+			Name: result.GetName(), ID: int(result.GetId()), Race: raceFromProto(result.GetRace()),
+		})
+	}
+	return results, nil
+}
+
+// SearchStrainsByFlavor returns the strains with the Flavor passed in.
+func (c *Client) SearchStrainsByFlavor(flavor strainapiclient.Flavor) (strainapiclient.SearchStrainsByFlavorResults, error) {
+	results := make(strainapiclient.SearchStrainsByFlavorResults, 0)
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	response, err := c.service.SearchStrainsByFlavor(ctx, &SearchStrainsByFlavorRequest{Flavor: string(flavor)})
+	if err != nil {
+		return results, errorFromStatus(err)
+	}
+
+	for _, result := range response.GetResults() {
+		results = append(results, strainapiclient.SearchStrainsByFlavorResult{
+			Name: result.GetName(), ID: int(result.GetId()), Race: raceFromProto(result.GetRace()), Flavor: strainapiclient.Flavor(result.GetFlavor()),
+		})
+	}
+	return results, nil
+}
+
+// SearchStrainsByEffectName returns the strains with the effect passed in.
+func (c *Client) SearchStrainsByEffectName(effectName string) (strainapiclient.SearchStrainsByEffectNameResults, error) {
+	results := make(strainapiclient.SearchStrainsByEffectNameResults, 0)
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	response, err := c.service.SearchStrainsByEffectName(ctx, &SearchStrainsByEffectNameRequest{EffectName: effectName})
+	if err != nil {
+		return results, errorFromStatus(err)
+	}
+
+	for _, result := range response.GetResults() {
+		results = append(results, strainapiclient.SearchStrainsByEffectNameResult{
+			Name: result.GetName(), ID: int(result.GetId()), Race: raceFromProto(result.GetRace()), EffectName: result.GetEffect(),
+		})
+	}
+	return results, nil
+}
+
+// GetStrainDescriptionByStrainID returns the description of a strain, or
+// ErrDescriptionNotFound if it has none.
+func (c *Client) GetStrainDescriptionByStrainID(id int) (string, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	response, err := c.service.GetStrainDescription(ctx, &GetStrainDescriptionRequest{StrainId: int32(id)})
+	if status.Code(err) == codes.NotFound {
+		return "", strainapiclient.ErrDescriptionNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("Problem retrieving description for Strain with ID %d: %w", id, errorFromStatus(err))
+	}
+
+	return response.GetDescription(), nil
+}
+
+// GetStrainFlavorsByStrainID returns the flavors of a strain.
+func (c *Client) GetStrainFlavorsByStrainID(id int) ([]strainapiclient.Flavor, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	response, err := c.service.GetStrainFlavors(ctx, &GetStrainFlavorsRequest{StrainId: int32(id)})
+	if err != nil {
+		return make([]strainapiclient.Flavor, 0), fmt.Errorf("Problem retrieving flavors for Strain with ID %d: %w", id, errorFromStatus(err))
+	}
+
+	return flavorsFromProto(response.GetFlavors()), nil
+}
+
+// GetStrainEffectsByStrainID returns the effects of a strain by EffectType.
+func (c *Client) GetStrainEffectsByStrainID(id int) (strainapiclient.EffectsByEffectType, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	response, err := c.service.GetStrainEffects(ctx, &GetStrainEffectsRequest{StrainId: int32(id)})
+	if err != nil {
+		return make(strainapiclient.EffectsByEffectType), fmt.Errorf("Problem retrieving effects for Strain with ID %d: %w", id, errorFromStatus(err))
+	}
+
+	return effectsFromProto(response.GetEffects()), nil
+}
+
+// errorFromStatus maps gRPC status codes back to the errors of a
+// DefaultClient.
+func errorFromStatus(err error) error {
+	message := status.Convert(err).Message()
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return &strainapiclient.ConnectionError{Err: err}
+	case codes.NotFound:
+		return &strainapiclient.StatusError{StatusCode: http.StatusNotFound, Body: message}
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return &strainapiclient.StatusError{StatusCode: http.StatusForbidden, Body: message}
+	case codes.ResourceExhausted:
+		return &strainapiclient.StatusError{StatusCode: http.StatusTooManyRequests, Body: message}
+	case codes.InvalidArgument:
+		return &strainapiclient.StatusError{StatusCode: http.StatusBadRequest, Body: message}
+	}
+
+	return err
+}
@@ -0,0 +1,145 @@
+package grpcapi
+
+import (
+	"sort"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+var racesToProto = map[strainapiclient.Race]Race{
+	strainapiclient.RaceIndica: Race_RACE_INDICA,
+	strainapiclient.RaceSativa: Race_RACE_SATIVA,
+	strainapiclient.RaceHybrid: Race_RACE_HYBRID,
+}
+
+var effectTypesToProto = map[strainapiclient.EffectType]EffectType{
+	strainapiclient.EffectTypePositive: EffectType_EFFECT_TYPE_POSITIVE,
+	strainapiclient.EffectTypeNegative: EffectType_EFFECT_TYPE_NEGATIVE,
+	strainapiclient.EffectTypeMedical:  EffectType_EFFECT_TYPE_MEDICAL,
+}
+
+// effectTypeOrder is the order the effects of a strain are sent in.
+var effectTypeOrder = []strainapiclient.EffectType{
+	strainapiclient.EffectTypePositive, strainapiclient.EffectTypeNegative, strainapiclient.EffectTypeMedical,
+}
+
+func raceToProto(race strainapiclient.Race) Race {
+	return racesToProto[race]
+}
+
+// raceFromProto returns an empty Race for RACE_UNSPECIFIED.
+func raceFromProto(race Race) strainapiclient.Race {
+	for clientRace, protoRace := range racesToProto {
+		if protoRace == race {
+			return clientRace
+		}
+	}
+	return ""
+}
+
+func effectTypeToProto(effectType strainapiclient.EffectType) EffectType {
+	return effectTypesToProto[effectType]
+}
+
+func effectTypeFromProto(effectType EffectType) strainapiclient.EffectType {
+	for clientEffectType, protoEffectType := range effectTypesToProto {
+		if protoEffectType == effectType {
+			return clientEffectType
+		}
+	}
+	return ""
+}
+
+func flavorsToProto(flavors []strainapiclient.Flavor) []*Flavor {
+	result := make([]*Flavor, len(flavors))
+	for index, flavor := range flavors {
+		result[index] = &Flavor{Name: string(flavor)}
+	}
+	return result
+}
+
+func flavorsFromProto(flavors []*Flavor) []strainapiclient.Flavor {
+	result := make([]strainapiclient.Flavor, len(flavors))
+	for index, flavor := range flavors {
+		result[index] = strainapiclient.Flavor(flavor.GetName())
+	}
+	return result
+}
+
+func effectToProto(effect strainapiclient.Effect) *Effect {
+	return &Effect{Name: effect.Name, Type: effectTypeToProto(effect.Type)}
+}
+
+func effectFromProto(effect *Effect) strainapiclient.Effect {
+	return strainapiclient.Effect{Name: effect.GetName(), Type: effectTypeFromProto(effect.GetType())}
+}
+
+// effectsToProto flattens effects keyed by type, in the order of
+// effectTypeOrder.  Effects of types without an EffectType are left out.
+func effectsToProto(effects strainapiclient.EffectsByEffectType) []*Effect {
+	result := make([]*Effect, 0)
+	for _, effectType := range effectTypeOrder {
+		for _, effect := range effects[effectType] {
+			result = append(result, &Effect{Name: effect.Name, Type: effectTypeToProto(effectType)})
+		}
+	}
+	return result
+}
+
+func effectsFromProto(effects []*Effect) strainapiclient.EffectsByEffectType {
+	result := make(strainapiclient.EffectsByEffectType)
+	for _, effect := range effects {
+		converted := effectFromProto(effect)
+		result[converted.Type] = append(result[converted.Type], converted)
+	}
+	return result
+}
+
+func strainToProto(strain strainapiclient.Strain) *Strain {
+	effects := make(strainapiclient.EffectsByEffectType)
+	for effectType, names := range strain.Effects {
+		for _, name := range names {
+			effects[effectType] = append(effects[effectType], strainapiclient.Effect{Name: name, Type: effectType})
+		}
+	}
+
+	return &Strain{
+		Id:          int32(strain.ID),
+		Name:        strain.Name,
+		Description: strain.Description,
+		Race:        raceToProto(strain.Race),
+		Flavors:     flavorsToProto(strain.Flavors),
+		Effects:     effectsToProto(effects),
+	}
+}
+
+func strainFromProto(strain *Strain) strainapiclient.Strain {
+	effects := make(map[strainapiclient.EffectType][]string)
+	for _, effect := range strain.GetEffects() {
+		effectType := effectTypeFromProto(effect.GetType())
+		effects[effectType] = append(effects[effectType], effect.GetName())
+	}
+
+	return strainapiclient.Strain{
+		Name:        strain.GetName(),
+		ID:          int(strain.GetId()),
+		Description: strain.GetDescription(),
+		Race:        raceFromProto(strain.GetRace()),
+		Flavors:     flavorsFromProto(strain.GetFlavors()),
+		Effects:     effects,
+	}
+}
+
+// sortedStrains returns the strains of a ListAllStrainsResult in order of ID.
+func sortedStrains(strains strainapiclient.ListAllStrainsResult) []strainapiclient.Strain {
+	result := make([]strainapiclient.Strain, 0, len(strains))
+	for _, strain := range strains {
+		result = append(result, strain)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
@@ -0,0 +1,139 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestClient serves upstream over an in-memory connection and returns a
+// Client connected to it.
+func newTestClient(t *testing.T, upstream strainapiclient.Client) *Client {
+	listener := bufconn.Listen(1024 * 1024)
+
+	grpcServer := grpc.NewServer()
+	RegisterStrainServiceServer(grpcServer, NewServer(upstream))
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return NewClient(conn)
+}
+
+func TestClientConformance(t *testing.T) {
+	clienttest.RunClientConformance(t, func(t *testing.T) strainapiclient.Client {
+		return newTestClient(t, clienttest.NewFixtureClient())
+	})
+}
+
+func TestStreamAllStrains(t *testing.T) {
+	client := newTestClient(t, clienttest.NewFixtureClient())
+
+	streamed := make([]strainapiclient.Strain, 0)
+	err := client.StreamAllStrains(context.Background(), func(strain strainapiclient.Strain) error {
+		streamed = append(streamed, strain)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := clienttest.KnownDataset().Strains
+	if len(streamed) != len(expected) {
+		t.Fatalf("Expected %d strains, got %d", len(expected), len(streamed))
+	}
+	for index, strain := range streamed {
+		if strain.ID != expected[index].ID || strain.Name != expected[index].Name || len(strain.Effects) != 3 {
+			t.Errorf("Expected strain %d to be %s with its effects, got %+v", index, expected[index].Name, strain)
+		}
+	}
+
+	stop := errors.New("stop")
+	count := 0
+	err = client.StreamAllStrains(context.Background(), func(strain strainapiclient.Strain) error {
+		count++
+		return stop
+	})
+	if err != stop || count != 1 {
+		t.Errorf("Expected the stream to stop at the first error, got %v after %d strains", err, count)
+	}
+}
+
+// failingUpstream is a Client whose lists fail with err.
+type failingUpstream struct {
+	strainapiclient.Client
+	err error
+}
+
+func (f failingUpstream) ListAllEffects() ([]strainapiclient.Effect, error) {
+	return nil, f.err
+}
+
+func TestErrorsSurviveTheTransport(t *testing.T) {
+	tests := []struct {
+		upstreamErr error
+		check       func(err error) bool
+	}{
+		{
+			&strainapiclient.ConnectionError{Err: errors.New("connection refused")},
+			func(err error) bool {
+				var connectionErr *strainapiclient.ConnectionError
+				return errors.As(err, &connectionErr)
+			},
+		},
+		{
+			&strainapiclient.StatusError{StatusCode: http.StatusUnauthorized, Body: "Bad key"},
+			func(err error) bool {
+				var statusErr *strainapiclient.StatusError
+				return errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusForbidden
+			},
+		},
+		{
+			&strainapiclient.StatusError{StatusCode: http.StatusBadRequest, Body: "Bad request"},
+			func(err error) bool {
+				var statusErr *strainapiclient.StatusError
+				return errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusBadRequest
+			},
+		},
+	}
+
+	for _, test := range tests {
+		client := newTestClient(t, failingUpstream{Client: clienttest.NewFixtureClient(), err: test.upstreamErr})
+
+		_, err := client.ListAllEffects()
+		if !test.check(err) {
+			t.Errorf("Unexpected error for an upstream %T: %#v", test.upstreamErr, err)
+		}
+	}
+
+	badRequest := &strainapiclient.StatusError{StatusCode: http.StatusBadRequest}
+	if code := status.Code(statusFromError(badRequest)); code != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for a bad request, got %v", code)
+	}
+
+	client := newTestClient(t, clienttest.NewFixtureClient())
+	if _, err := client.GetStrainDescriptionByStrainID(clienttest.UnknownStrainID); err != strainapiclient.ErrDescriptionNotFound {
+		t.Errorf("Expected ErrDescriptionNotFound for an unknown strain, got %v", err)
+	}
+}
@@ -0,0 +1,220 @@
+// Package grpcapi serves a strainapiclient.Client over gRPC and provides a
+// strainapiclient.Client that calls such a server, so consumers can switch
+// transports without changing their code.
+//
+// The service is defined in strainapi.proto.  After changing it, regenerate
+// strainapi.pb.go and strainapi_grpc.pb.go with protoc-gen-go v1.27.1 and
+// protoc-gen-go-grpc v1.1.0:
+//
+//	protoc --go_out=. --go_opt=paths=source_relative \
+//	    --go-grpc_out=. --go-grpc_opt=paths=source_relative strainapi.proto
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/tchype/strainapiclient-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server implements StrainServiceServer on top of a Client:
+//
+//	grpcServer := grpc.NewServer()
+//	grpcapi.RegisterStrainServiceServer(grpcServer, grpcapi.NewServer(client))
+type Server struct {
+	UnimplementedStrainServiceServer
+
+	client strainapiclient.Client
+}
+
+// NewServer creates a Server that answers from client.
+func NewServer(client strainapiclient.Client) *Server {
+	return &Server{client: client}
+}
+
+// ListAllEffects implements StrainServiceServer.
+func (s *Server) ListAllEffects(ctx context.Context, request *ListAllEffectsRequest) (*ListAllEffectsResponse, error) {
+	effects, err := s.client.ListAllEffects()
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	response := &ListAllEffectsResponse{Effects: make([]*Effect, len(effects))}
+	for index, effect := range effects {
+		response.Effects[index] = effectToProto(effect)
+	}
+	return response, nil
+}
+
+// ListAllFlavors implements StrainServiceServer.
+func (s *Server) ListAllFlavors(ctx context.Context, request *ListAllFlavorsRequest) (*ListAllFlavorsResponse, error) {
+	flavors, err := s.client.ListAllFlavors()
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &ListAllFlavorsResponse{Flavors: flavorsToProto(flavors)}, nil
+}
+
+// ListAllStrains implements StrainServiceServer.
+func (s *Server) ListAllStrains(ctx context.Context, request *ListAllStrainsRequest) (*ListAllStrainsResponse, error) {
+	strains, err := s.client.ListAllStrains()
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	response := &ListAllStrainsResponse{Strains: make([]*Strain, 0, len(strains))}
+	for _, strain := range sortedStrains(strains) {
+		response.Strains = append(response.Strains, strainToProto(strain))
+	}
+	return response, nil
+}
+
+// StreamAllStrains implements StrainServiceServer.
+func (s *Server) StreamAllStrains(request *StreamAllStrainsRequest, stream StrainService_StreamAllStrainsServer) error {
+	strains, err := s.client.ListAllStrains()
+	if err != nil {
+		return statusFromError(err)
+	}
+
+	for _, strain := range sortedStrains(strains) {
+		if err := stream.Send(strainToProto(strain)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SearchStrainsByName implements StrainServiceServer.
+func (s *Server) SearchStrainsByName(ctx context.Context, request *SearchStrainsByNameRequest) (*SearchStrainsResponse, error) {
+	results, err := s.client.SearchStrainsByName(request.GetName())
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	response := &SearchStrainsResponse{Results: make([]*StrainSearchResult, len(results))}
+	for index, result := range results {
+		response.Results[index] = &StrainSearchResult{
+			Id: int32(result.ID), Name: result.Name, Race: raceToProto(result.Race), Description: result.Description,
+		}
+	}
+	return response, nil
+}
+
+// SearchStrainsByRace implements StrainServiceServer.  There are no strains
+// of RACE_UNSPECIFIED.
+func (s *Server) SearchStrainsByRace(ctx context.Context, request *SearchStrainsByRaceRequest) (*SearchStrainsResponse, error) {
+	response := &SearchStrainsResponse{Results: make([]*StrainSearchResult, 0)}
+
+	race := raceFromProto(request.GetRace())
+	if race == "" {
+		return response, nil
+	}
+
+	results, err := s.client.SearchStrainsByRace(race)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	for _, result := range results {
+		response.Results = append(response.Results, &StrainSearchResult{
+			Id: int32(result.ID), Name: result.Name, Race: raceToProto(result.Race),
+		})
+	}
+	return response, nil
+}
+
+// SearchStrainsByFlavor implements StrainServiceServer.
+func (s *Server) SearchStrainsByFlavor(ctx context.Context, request *SearchStrainsByFlavorRequest) (*SearchStrainsResponse, error) {
+	results, err := s.client.SearchStrainsByFlavor(strainapiclient.Flavor(request.GetFlavor()))
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	response := &SearchStrainsResponse{Results: make([]*StrainSearchResult, len(results))}
+	for index, result := range results {
+		response.Results[index] = &StrainSearchResult{
+			Id: int32(result.ID), Name: result.Name, Race: raceToProto(result.Race), Flavor: string(result.Flavor),
+		}
+	}
+	return response, nil
+}
+
+// SearchStrainsByEffectName implements StrainServiceServer.
+func (s *Server) SearchStrainsByEffectName(ctx context.Context, request *SearchStrainsByEffectNameRequest) (*SearchStrainsResponse, error) {
+	results, err := s.client.SearchStrainsByEffectName(request.GetEffectName())
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	response := &SearchStrainsResponse{Results: make([]*StrainSearchResult, len(results))}
+	for index, result := range results {
+		response.Results[index] = &StrainSearchResult{
+			Id: int32(result.ID), Name: result.Name, Race: raceToProto(result.Race), Effect: result.EffectName,
+		}
+	}
+	return response, nil
+}
+
+// GetStrainDescription implements StrainServiceServer.
+func (s *Server) GetStrainDescription(ctx context.Context, request *GetStrainDescriptionRequest) (*GetStrainDescriptionResponse, error) {
+	description, err := s.client.GetStrainDescriptionByStrainID(int(request.GetStrainId()))
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &GetStrainDescriptionResponse{Description: description}, nil
+}
+
+// GetStrainFlavors implements StrainServiceServer.
+func (s *Server) GetStrainFlavors(ctx context.Context, request *GetStrainFlavorsRequest) (*GetStrainFlavorsResponse, error) {
+	flavors, err := s.client.GetStrainFlavorsByStrainID(int(request.GetStrainId()))
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &GetStrainFlavorsResponse{Flavors: flavorsToProto(flavors)}, nil
+}
+
+// GetStrainEffects implements StrainServiceServer.
+func (s *Server) GetStrainEffects(ctx context.Context, request *GetStrainEffectsRequest) (*GetStrainEffectsResponse, error) {
+	effects, err := s.client.GetStrainEffectsByStrainID(int(request.GetStrainId()))
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &GetStrainEffectsResponse{Effects: effectsToProto(effects)}, nil
+}
+
+// statusFromError maps the errors of a Client to gRPC status codes.
+func statusFromError(err error) error {
+	code := codes.Unknown
+
+	var statusErr *strainapiclient.StatusError
+	var connectionErr *strainapiclient.ConnectionError
+
+	switch {
+	case errors.Is(err, strainapiclient.ErrDescriptionNotFound), errors.Is(err, strainapiclient.ErrStrainNotFound):
+		code = codes.NotFound
+	case errors.As(err, &connectionErr), errors.Is(err, strainapiclient.ErrCircuitOpen):
+		code = codes.Unavailable
+	case errors.As(err, &statusErr):
+		switch {
+		case statusErr.StatusCode == http.StatusBadRequest:
+			code = codes.InvalidArgument
+		case statusErr.StatusCode == http.StatusNotFound:
+			code = codes.NotFound
+		case statusErr.StatusCode == http.StatusUnauthorized, statusErr.StatusCode == http.StatusForbidden:
+			code = codes.PermissionDenied
+		case statusErr.StatusCode == http.StatusTooManyRequests:
+			code = codes.ResourceExhausted
+		case statusErr.StatusCode >= 500:
+			code = codes.Unavailable
+		}
+	}
+
+	return status.Error(code, err.Error())
+}
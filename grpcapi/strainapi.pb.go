@@ -0,0 +1,1786 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: strainapi.proto
+
+// The strain catalog of The Strain API, mirroring the strainapiclient.Client
+// interface.
+
+package grpcapi
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Race is the type of a strain.
+type Race int32
+
+const (
+	Race_RACE_UNSPECIFIED Race = 0
+	Race_RACE_INDICA      Race = 1
+	Race_RACE_SATIVA      Race = 2
+	Race_RACE_HYBRID      Race = 3
+)
+
+// Enum value maps for Race.
+var (
+	Race_name = map[int32]string{
+		0: "RACE_UNSPECIFIED",
+		1: "RACE_INDICA",
+		2: "RACE_SATIVA",
+		3: "RACE_HYBRID",
+	}
+	Race_value = map[string]int32{
+		"RACE_UNSPECIFIED": 0,
+		"RACE_INDICA":      1,
+		"RACE_SATIVA":      2,
+		"RACE_HYBRID":      3,
+	}
+)
+
+func (x Race) Enum() *Race {
+	p := new(Race)
+	*p = x
+	return p
+}
+
+func (x Race) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Race) Descriptor() protoreflect.EnumDescriptor {
+	return file_strainapi_proto_enumTypes[0].Descriptor()
+}
+
+func (Race) Type() protoreflect.EnumType {
+	return &file_strainapi_proto_enumTypes[0]
+}
+
+func (x Race) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Race.Descriptor instead.
+func (Race) EnumDescriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{0}
+}
+
+// EffectType is the kind of an effect.
+type EffectType int32
+
+const (
+	EffectType_EFFECT_TYPE_UNSPECIFIED EffectType = 0
+	EffectType_EFFECT_TYPE_POSITIVE    EffectType = 1
+	EffectType_EFFECT_TYPE_NEGATIVE    EffectType = 2
+	EffectType_EFFECT_TYPE_MEDICAL     EffectType = 3
+)
+
+// Enum value maps for EffectType.
+var (
+	EffectType_name = map[int32]string{
+		0: "EFFECT_TYPE_UNSPECIFIED",
+		1: "EFFECT_TYPE_POSITIVE",
+		2: "EFFECT_TYPE_NEGATIVE",
+		3: "EFFECT_TYPE_MEDICAL",
+	}
+	EffectType_value = map[string]int32{
+		"EFFECT_TYPE_UNSPECIFIED": 0,
+		"EFFECT_TYPE_POSITIVE":    1,
+		"EFFECT_TYPE_NEGATIVE":    2,
+		"EFFECT_TYPE_MEDICAL":     3,
+	}
+)
+
+func (x EffectType) Enum() *EffectType {
+	p := new(EffectType)
+	*p = x
+	return p
+}
+
+func (x EffectType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EffectType) Descriptor() protoreflect.EnumDescriptor {
+	return file_strainapi_proto_enumTypes[1].Descriptor()
+}
+
+func (EffectType) Type() protoreflect.EnumType {
+	return &file_strainapi_proto_enumTypes[1]
+}
+
+func (x EffectType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EffectType.Descriptor instead.
+func (EffectType) EnumDescriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{1}
+}
+
+// Effect is an effect that can be experienced when consuming a strain.
+type Effect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type EffectType `protobuf:"varint,2,opt,name=type,proto3,enum=strainapi.v1.EffectType" json:"type,omitempty"`
+}
+
+func (x *Effect) Reset() {
+	*x = Effect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Effect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Effect) ProtoMessage() {}
+
+func (x *Effect) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Effect.ProtoReflect.Descriptor instead.
+func (*Effect) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Effect) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Effect) GetType() EffectType {
+	if x != nil {
+		return x.Type
+	}
+	return EffectType_EFFECT_TYPE_UNSPECIFIED
+}
+
+// Flavor is a component of the flavor of a strain.
+type Flavor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Flavor) Reset() {
+	*x = Flavor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Flavor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Flavor) ProtoMessage() {}
+
+func (x *Flavor) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Flavor.ProtoReflect.Descriptor instead.
+func (*Flavor) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Flavor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Strain is a strain of cannabis and its properties.
+type Strain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int32     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string    `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Race        Race      `protobuf:"varint,4,opt,name=race,proto3,enum=strainapi.v1.Race" json:"race,omitempty"`
+	Flavors     []*Flavor `protobuf:"bytes,5,rep,name=flavors,proto3" json:"flavors,omitempty"`
+	Effects     []*Effect `protobuf:"bytes,6,rep,name=effects,proto3" json:"effects,omitempty"`
+}
+
+func (x *Strain) Reset() {
+	*x = Strain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Strain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Strain) ProtoMessage() {}
+
+func (x *Strain) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Strain.ProtoReflect.Descriptor instead.
+func (*Strain) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Strain) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Strain) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Strain) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Strain) GetRace() Race {
+	if x != nil {
+		return x.Race
+	}
+	return Race_RACE_UNSPECIFIED
+}
+
+func (x *Strain) GetFlavors() []*Flavor {
+	if x != nil {
+		return x.Flavors
+	}
+	return nil
+}
+
+func (x *Strain) GetEffects() []*Effect {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
+// StrainSearchResult is one strain found by a search.  description is only
+// set by SearchStrainsByName, effect by SearchStrainsByEffectName and flavor
+// by SearchStrainsByFlavor.
+type StrainSearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Race        Race   `protobuf:"varint,3,opt,name=race,proto3,enum=strainapi.v1.Race" json:"race,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Effect      string `protobuf:"bytes,5,opt,name=effect,proto3" json:"effect,omitempty"`
+	Flavor      string `protobuf:"bytes,6,opt,name=flavor,proto3" json:"flavor,omitempty"`
+}
+
+func (x *StrainSearchResult) Reset() {
+	*x = StrainSearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StrainSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrainSearchResult) ProtoMessage() {}
+
+func (x *StrainSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrainSearchResult.ProtoReflect.Descriptor instead.
+func (*StrainSearchResult) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StrainSearchResult) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StrainSearchResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StrainSearchResult) GetRace() Race {
+	if x != nil {
+		return x.Race
+	}
+	return Race_RACE_UNSPECIFIED
+}
+
+func (x *StrainSearchResult) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StrainSearchResult) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+func (x *StrainSearchResult) GetFlavor() string {
+	if x != nil {
+		return x.Flavor
+	}
+	return ""
+}
+
+type ListAllEffectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListAllEffectsRequest) Reset() {
+	*x = ListAllEffectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllEffectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllEffectsRequest) ProtoMessage() {}
+
+func (x *ListAllEffectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllEffectsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllEffectsRequest) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{4}
+}
+
+type ListAllEffectsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Effects []*Effect `protobuf:"bytes,1,rep,name=effects,proto3" json:"effects,omitempty"`
+}
+
+func (x *ListAllEffectsResponse) Reset() {
+	*x = ListAllEffectsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllEffectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllEffectsResponse) ProtoMessage() {}
+
+func (x *ListAllEffectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllEffectsResponse.ProtoReflect.Descriptor instead.
+func (*ListAllEffectsResponse) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAllEffectsResponse) GetEffects() []*Effect {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
+type ListAllFlavorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListAllFlavorsRequest) Reset() {
+	*x = ListAllFlavorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllFlavorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllFlavorsRequest) ProtoMessage() {}
+
+func (x *ListAllFlavorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllFlavorsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllFlavorsRequest) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{6}
+}
+
+type ListAllFlavorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flavors []*Flavor `protobuf:"bytes,1,rep,name=flavors,proto3" json:"flavors,omitempty"`
+}
+
+func (x *ListAllFlavorsResponse) Reset() {
+	*x = ListAllFlavorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllFlavorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllFlavorsResponse) ProtoMessage() {}
+
+func (x *ListAllFlavorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllFlavorsResponse.ProtoReflect.Descriptor instead.
+func (*ListAllFlavorsResponse) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListAllFlavorsResponse) GetFlavors() []*Flavor {
+	if x != nil {
+		return x.Flavors
+	}
+	return nil
+}
+
+type ListAllStrainsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListAllStrainsRequest) Reset() {
+	*x = ListAllStrainsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllStrainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllStrainsRequest) ProtoMessage() {}
+
+func (x *ListAllStrainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllStrainsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllStrainsRequest) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{8}
+}
+
+type ListAllStrainsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Strains []*Strain `protobuf:"bytes,1,rep,name=strains,proto3" json:"strains,omitempty"`
+}
+
+func (x *ListAllStrainsResponse) Reset() {
+	*x = ListAllStrainsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllStrainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllStrainsResponse) ProtoMessage() {}
+
+func (x *ListAllStrainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllStrainsResponse.ProtoReflect.Descriptor instead.
+func (*ListAllStrainsResponse) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListAllStrainsResponse) GetStrains() []*Strain {
+	if x != nil {
+		return x.Strains
+	}
+	return nil
+}
+
+type StreamAllStrainsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamAllStrainsRequest) Reset() {
+	*x = StreamAllStrainsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamAllStrainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamAllStrainsRequest) ProtoMessage() {}
+
+func (x *StreamAllStrainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamAllStrainsRequest.ProtoReflect.Descriptor instead.
+func (*StreamAllStrainsRequest) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{10}
+}
+
+type SearchStrainsByNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SearchStrainsByNameRequest) Reset() {
+	*x = SearchStrainsByNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchStrainsByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStrainsByNameRequest) ProtoMessage() {}
+
+func (x *SearchStrainsByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStrainsByNameRequest.ProtoReflect.Descriptor instead.
+func (*SearchStrainsByNameRequest) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchStrainsByNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SearchStrainsByRaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Race Race `protobuf:"varint,1,opt,name=race,proto3,enum=strainapi.v1.Race" json:"race,omitempty"`
+}
+
+func (x *SearchStrainsByRaceRequest) Reset() {
+	*x = SearchStrainsByRaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchStrainsByRaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStrainsByRaceRequest) ProtoMessage() {}
+
+func (x *SearchStrainsByRaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStrainsByRaceRequest.ProtoReflect.Descriptor instead.
+func (*SearchStrainsByRaceRequest) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchStrainsByRaceRequest) GetRace() Race {
+	if x != nil {
+		return x.Race
+	}
+	return Race_RACE_UNSPECIFIED
+}
+
+type SearchStrainsByFlavorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flavor string `protobuf:"bytes,1,opt,name=flavor,proto3" json:"flavor,omitempty"`
+}
+
+func (x *SearchStrainsByFlavorRequest) Reset() {
+	*x = SearchStrainsByFlavorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchStrainsByFlavorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStrainsByFlavorRequest) ProtoMessage() {}
+
+func (x *SearchStrainsByFlavorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStrainsByFlavorRequest.ProtoReflect.Descriptor instead.
+func (*SearchStrainsByFlavorRequest) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchStrainsByFlavorRequest) GetFlavor() string {
+	if x != nil {
+		return x.Flavor
+	}
+	return ""
+}
+
+type SearchStrainsByEffectNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EffectName string `protobuf:"bytes,1,opt,name=effect_name,json=effectName,proto3" json:"effect_name,omitempty"`
+}
+
+func (x *SearchStrainsByEffectNameRequest) Reset() {
+	*x = SearchStrainsByEffectNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchStrainsByEffectNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStrainsByEffectNameRequest) ProtoMessage() {}
+
+func (x *SearchStrainsByEffectNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStrainsByEffectNameRequest.ProtoReflect.Descriptor instead.
+func (*SearchStrainsByEffectNameRequest) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchStrainsByEffectNameRequest) GetEffectName() string {
+	if x != nil {
+		return x.EffectName
+	}
+	return ""
+}
+
+type SearchStrainsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*StrainSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SearchStrainsResponse) Reset() {
+	*x = SearchStrainsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchStrainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStrainsResponse) ProtoMessage() {}
+
+func (x *SearchStrainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStrainsResponse.ProtoReflect.Descriptor instead.
+func (*SearchStrainsResponse) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchStrainsResponse) GetResults() []*StrainSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetStrainDescriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StrainId int32 `protobuf:"varint,1,opt,name=strain_id,json=strainId,proto3" json:"strain_id,omitempty"`
+}
+
+func (x *GetStrainDescriptionRequest) Reset() {
+	*x = GetStrainDescriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStrainDescriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStrainDescriptionRequest) ProtoMessage() {}
+
+func (x *GetStrainDescriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStrainDescriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetStrainDescriptionRequest) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetStrainDescriptionRequest) GetStrainId() int32 {
+	if x != nil {
+		return x.StrainId
+	}
+	return 0
+}
+
+type GetStrainDescriptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *GetStrainDescriptionResponse) Reset() {
+	*x = GetStrainDescriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStrainDescriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStrainDescriptionResponse) ProtoMessage() {}
+
+func (x *GetStrainDescriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStrainDescriptionResponse.ProtoReflect.Descriptor instead.
+func (*GetStrainDescriptionResponse) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetStrainDescriptionResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type GetStrainFlavorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StrainId int32 `protobuf:"varint,1,opt,name=strain_id,json=strainId,proto3" json:"strain_id,omitempty"`
+}
+
+func (x *GetStrainFlavorsRequest) Reset() {
+	*x = GetStrainFlavorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStrainFlavorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStrainFlavorsRequest) ProtoMessage() {}
+
+func (x *GetStrainFlavorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStrainFlavorsRequest.ProtoReflect.Descriptor instead.
+func (*GetStrainFlavorsRequest) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetStrainFlavorsRequest) GetStrainId() int32 {
+	if x != nil {
+		return x.StrainId
+	}
+	return 0
+}
+
+type GetStrainFlavorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flavors []*Flavor `protobuf:"bytes,1,rep,name=flavors,proto3" json:"flavors,omitempty"`
+}
+
+func (x *GetStrainFlavorsResponse) Reset() {
+	*x = GetStrainFlavorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStrainFlavorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStrainFlavorsResponse) ProtoMessage() {}
+
+func (x *GetStrainFlavorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStrainFlavorsResponse.ProtoReflect.Descriptor instead.
+func (*GetStrainFlavorsResponse) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetStrainFlavorsResponse) GetFlavors() []*Flavor {
+	if x != nil {
+		return x.Flavors
+	}
+	return nil
+}
+
+type GetStrainEffectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StrainId int32 `protobuf:"varint,1,opt,name=strain_id,json=strainId,proto3" json:"strain_id,omitempty"`
+}
+
+func (x *GetStrainEffectsRequest) Reset() {
+	*x = GetStrainEffectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStrainEffectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStrainEffectsRequest) ProtoMessage() {}
+
+func (x *GetStrainEffectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStrainEffectsRequest.ProtoReflect.Descriptor instead.
+func (*GetStrainEffectsRequest) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetStrainEffectsRequest) GetStrainId() int32 {
+	if x != nil {
+		return x.StrainId
+	}
+	return 0
+}
+
+type GetStrainEffectsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Effects []*Effect `protobuf:"bytes,1,rep,name=effects,proto3" json:"effects,omitempty"`
+}
+
+func (x *GetStrainEffectsResponse) Reset() {
+	*x = GetStrainEffectsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_strainapi_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStrainEffectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStrainEffectsResponse) ProtoMessage() {}
+
+func (x *GetStrainEffectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_strainapi_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStrainEffectsResponse.ProtoReflect.Descriptor instead.
+func (*GetStrainEffectsResponse) Descriptor() ([]byte, []int) {
+	return file_strainapi_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetStrainEffectsResponse) GetEffects() []*Effect {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
+var File_strainapi_proto protoreflect.FileDescriptor
+
+var file_strainapi_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x22,
+	0x4a, 0x0a, 0x06, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x1c, 0x0a, 0x06, 0x46,
+	0x6c, 0x61, 0x76, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x06, 0x53, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x04, 0x72, 0x61,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63, 0x65, 0x52, 0x04, 0x72, 0x61,
+	0x63, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x07, 0x66, 0x6c, 0x61, 0x76, 0x6f,
+	0x72, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a,
+	0x04, 0x72, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63, 0x65, 0x52,
+	0x04, 0x72, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x22, 0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x6c, 0x6c, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x48, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x52, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x6c,
+	0x61, 0x76, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x07, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c,
+	0x61, 0x76, 0x6f, 0x72, 0x52, 0x07, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x73, 0x22, 0x17, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x6c, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x07, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73,
+	0x22, 0x19, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x30, 0x0a, 0x1a, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x42, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a,
+	0x1a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x42, 0x79,
+	0x52, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x72,
+	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x63, 0x65, 0x52, 0x04, 0x72,
+	0x61, 0x63, 0x65, 0x22, 0x36, 0x0a, 0x1c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x73, 0x42, 0x79, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x22, 0x43, 0x0a, 0x20, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x42, 0x79, 0x45, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x53, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x3a, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x22, 0x40, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x66, 0x6c, 0x61, 0x76, 0x6f,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x07,
+	0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x73, 0x22, 0x36, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22,
+	0x4a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x45, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x52, 0x07, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x2a, 0x4f, 0x0a, 0x04, 0x52,
+	0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x41, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x43,
+	0x45, 0x5f, 0x49, 0x4e, 0x44, 0x49, 0x43, 0x41, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41,
+	0x43, 0x45, 0x5f, 0x53, 0x41, 0x54, 0x49, 0x56, 0x41, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x52,
+	0x41, 0x43, 0x45, 0x5f, 0x48, 0x59, 0x42, 0x52, 0x49, 0x44, 0x10, 0x03, 0x2a, 0x76, 0x0a, 0x0a,
+	0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x46,
+	0x46, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x46, 0x46, 0x45, 0x43,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x56, 0x45, 0x10,
+	0x01, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x46, 0x46, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4e, 0x45, 0x47, 0x41, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x45,
+	0x46, 0x46, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x43,
+	0x41, 0x4c, 0x10, 0x03, 0x32, 0xd6, 0x08, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x6c, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x45,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x6c, 0x6c, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x6c,
+	0x61, 0x76, 0x6f, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x46, 0x6c, 0x61, 0x76,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x6c, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5b, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a,
+	0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x73, 0x12, 0x25, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x30, 0x01,
+	0x12, 0x64, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x42, 0x79, 0x52, 0x61, 0x63, 0x65, 0x12, 0x28, 0x2e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x42, 0x79, 0x52, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x15,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x42, 0x79, 0x46,
+	0x6c, 0x61, 0x76, 0x6f, 0x72, 0x12, 0x2a, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x73, 0x42, 0x79, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73, 0x42, 0x79, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2e, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73,
+	0x42, 0x79, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x29, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x46, 0x6c, 0x61, 0x76, 0x6f,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x12, 0x25,
+	0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x45, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2e, 0x5a,
+	0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x63, 0x68, 0x79,
+	0x70, 0x65, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x61, 0x70, 0x69, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x2d, 0x67, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_strainapi_proto_rawDescOnce sync.Once
+	file_strainapi_proto_rawDescData = file_strainapi_proto_rawDesc
+)
+
+func file_strainapi_proto_rawDescGZIP() []byte {
+	file_strainapi_proto_rawDescOnce.Do(func() {
+		file_strainapi_proto_rawDescData = protoimpl.X.CompressGZIP(file_strainapi_proto_rawDescData)
+	})
+	return file_strainapi_proto_rawDescData
+}
+
+var file_strainapi_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_strainapi_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_strainapi_proto_goTypes = []interface{}{
+	(Race)(0),                                // 0: strainapi.v1.Race
+	(EffectType)(0),                          // 1: strainapi.v1.EffectType
+	(*Effect)(nil),                           // 2: strainapi.v1.Effect
+	(*Flavor)(nil),                           // 3: strainapi.v1.Flavor
+	(*Strain)(nil),                           // 4: strainapi.v1.Strain
+	(*StrainSearchResult)(nil),               // 5: strainapi.v1.StrainSearchResult
+	(*ListAllEffectsRequest)(nil),            // 6: strainapi.v1.ListAllEffectsRequest
+	(*ListAllEffectsResponse)(nil),           // 7: strainapi.v1.ListAllEffectsResponse
+	(*ListAllFlavorsRequest)(nil),            // 8: strainapi.v1.ListAllFlavorsRequest
+	(*ListAllFlavorsResponse)(nil),           // 9: strainapi.v1.ListAllFlavorsResponse
+	(*ListAllStrainsRequest)(nil),            // 10: strainapi.v1.ListAllStrainsRequest
+	(*ListAllStrainsResponse)(nil),           // 11: strainapi.v1.ListAllStrainsResponse
+	(*StreamAllStrainsRequest)(nil),          // 12: strainapi.v1.StreamAllStrainsRequest
+	(*SearchStrainsByNameRequest)(nil),       // 13: strainapi.v1.SearchStrainsByNameRequest
+	(*SearchStrainsByRaceRequest)(nil),       // 14: strainapi.v1.SearchStrainsByRaceRequest
+	(*SearchStrainsByFlavorRequest)(nil),     // 15: strainapi.v1.SearchStrainsByFlavorRequest
+	(*SearchStrainsByEffectNameRequest)(nil), // 16: strainapi.v1.SearchStrainsByEffectNameRequest
+	(*SearchStrainsResponse)(nil),            // 17: strainapi.v1.SearchStrainsResponse
+	(*GetStrainDescriptionRequest)(nil),      // 18: strainapi.v1.GetStrainDescriptionRequest
+	(*GetStrainDescriptionResponse)(nil),     // 19: strainapi.v1.GetStrainDescriptionResponse
+	(*GetStrainFlavorsRequest)(nil),          // 20: strainapi.v1.GetStrainFlavorsRequest
+	(*GetStrainFlavorsResponse)(nil),         // 21: strainapi.v1.GetStrainFlavorsResponse
+	(*GetStrainEffectsRequest)(nil),          // 22: strainapi.v1.GetStrainEffectsRequest
+	(*GetStrainEffectsResponse)(nil),         // 23: strainapi.v1.GetStrainEffectsResponse
+}
+var file_strainapi_proto_depIdxs = []int32{
+	1,  // 0: strainapi.v1.Effect.type:type_name -> strainapi.v1.EffectType
+	0,  // 1: strainapi.v1.Strain.race:type_name -> strainapi.v1.Race
+	3,  // 2: strainapi.v1.Strain.flavors:type_name -> strainapi.v1.Flavor
+	2,  // 3: strainapi.v1.Strain.effects:type_name -> strainapi.v1.Effect
+	0,  // 4: strainapi.v1.StrainSearchResult.race:type_name -> strainapi.v1.Race
+	2,  // 5: strainapi.v1.ListAllEffectsResponse.effects:type_name -> strainapi.v1.Effect
+	3,  // 6: strainapi.v1.ListAllFlavorsResponse.flavors:type_name -> strainapi.v1.Flavor
+	4,  // 7: strainapi.v1.ListAllStrainsResponse.strains:type_name -> strainapi.v1.Strain
+	0,  // 8: strainapi.v1.SearchStrainsByRaceRequest.race:type_name -> strainapi.v1.Race
+	5,  // 9: strainapi.v1.SearchStrainsResponse.results:type_name -> strainapi.v1.StrainSearchResult
+	3,  // 10: strainapi.v1.GetStrainFlavorsResponse.flavors:type_name -> strainapi.v1.Flavor
+	2,  // 11: strainapi.v1.GetStrainEffectsResponse.effects:type_name -> strainapi.v1.Effect
+	6,  // 12: strainapi.v1.StrainService.ListAllEffects:input_type -> strainapi.v1.ListAllEffectsRequest
+	8,  // 13: strainapi.v1.StrainService.ListAllFlavors:input_type -> strainapi.v1.ListAllFlavorsRequest
+	10, // 14: strainapi.v1.StrainService.ListAllStrains:input_type -> strainapi.v1.ListAllStrainsRequest
+	12, // 15: strainapi.v1.StrainService.StreamAllStrains:input_type -> strainapi.v1.StreamAllStrainsRequest
+	13, // 16: strainapi.v1.StrainService.SearchStrainsByName:input_type -> strainapi.v1.SearchStrainsByNameRequest
+	14, // 17: strainapi.v1.StrainService.SearchStrainsByRace:input_type -> strainapi.v1.SearchStrainsByRaceRequest
+	15, // 18: strainapi.v1.StrainService.SearchStrainsByFlavor:input_type -> strainapi.v1.SearchStrainsByFlavorRequest
+	16, // 19: strainapi.v1.StrainService.SearchStrainsByEffectName:input_type -> strainapi.v1.SearchStrainsByEffectNameRequest
+	18, // 20: strainapi.v1.StrainService.GetStrainDescription:input_type -> strainapi.v1.GetStrainDescriptionRequest
+	20, // 21: strainapi.v1.StrainService.GetStrainFlavors:input_type -> strainapi.v1.GetStrainFlavorsRequest
+	22, // 22: strainapi.v1.StrainService.GetStrainEffects:input_type -> strainapi.v1.GetStrainEffectsRequest
+	7,  // 23: strainapi.v1.StrainService.ListAllEffects:output_type -> strainapi.v1.ListAllEffectsResponse
+	9,  // 24: strainapi.v1.StrainService.ListAllFlavors:output_type -> strainapi.v1.ListAllFlavorsResponse
+	11, // 25: strainapi.v1.StrainService.ListAllStrains:output_type -> strainapi.v1.ListAllStrainsResponse
+	4,  // 26: strainapi.v1.StrainService.StreamAllStrains:output_type -> strainapi.v1.Strain
+	17, // 27: strainapi.v1.StrainService.SearchStrainsByName:output_type -> strainapi.v1.SearchStrainsResponse
+	17, // 28: strainapi.v1.StrainService.SearchStrainsByRace:output_type -> strainapi.v1.SearchStrainsResponse
+	17, // 29: strainapi.v1.StrainService.SearchStrainsByFlavor:output_type -> strainapi.v1.SearchStrainsResponse
+	17, // 30: strainapi.v1.StrainService.SearchStrainsByEffectName:output_type -> strainapi.v1.SearchStrainsResponse
+	19, // 31: strainapi.v1.StrainService.GetStrainDescription:output_type -> strainapi.v1.GetStrainDescriptionResponse
+	21, // 32: strainapi.v1.StrainService.GetStrainFlavors:output_type -> strainapi.v1.GetStrainFlavorsResponse
+	23, // 33: strainapi.v1.StrainService.GetStrainEffects:output_type -> strainapi.v1.GetStrainEffectsResponse
+	23, // [23:34] is the sub-list for method output_type
+	12, // [12:23] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_strainapi_proto_init() }
+func file_strainapi_proto_init() {
+	if File_strainapi_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_strainapi_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Effect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Flavor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Strain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StrainSearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllEffectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllEffectsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllFlavorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllFlavorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllStrainsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllStrainsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamAllStrainsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchStrainsByNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchStrainsByRaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchStrainsByFlavorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchStrainsByEffectNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchStrainsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStrainDescriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStrainDescriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStrainFlavorsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStrainFlavorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStrainEffectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_strainapi_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStrainEffectsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_strainapi_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   22,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_strainapi_proto_goTypes,
+		DependencyIndexes: file_strainapi_proto_depIdxs,
+		EnumInfos:         file_strainapi_proto_enumTypes,
+		MessageInfos:      file_strainapi_proto_msgTypes,
+	}.Build()
+	File_strainapi_proto = out.File
+	file_strainapi_proto_rawDesc = nil
+	file_strainapi_proto_goTypes = nil
+	file_strainapi_proto_depIdxs = nil
+}
@@ -0,0 +1,493 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package grpcapi
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// StrainServiceClient is the client API for StrainService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StrainServiceClient interface {
+	ListAllEffects(ctx context.Context, in *ListAllEffectsRequest, opts ...grpc.CallOption) (*ListAllEffectsResponse, error)
+	ListAllFlavors(ctx context.Context, in *ListAllFlavorsRequest, opts ...grpc.CallOption) (*ListAllFlavorsResponse, error)
+	ListAllStrains(ctx context.Context, in *ListAllStrainsRequest, opts ...grpc.CallOption) (*ListAllStrainsResponse, error)
+	// StreamAllStrains sends every strain, in order of ID, one message at a
+	// time.
+	StreamAllStrains(ctx context.Context, in *StreamAllStrainsRequest, opts ...grpc.CallOption) (StrainService_StreamAllStrainsClient, error)
+	SearchStrainsByName(ctx context.Context, in *SearchStrainsByNameRequest, opts ...grpc.CallOption) (*SearchStrainsResponse, error)
+	SearchStrainsByRace(ctx context.Context, in *SearchStrainsByRaceRequest, opts ...grpc.CallOption) (*SearchStrainsResponse, error)
+	SearchStrainsByFlavor(ctx context.Context, in *SearchStrainsByFlavorRequest, opts ...grpc.CallOption) (*SearchStrainsResponse, error)
+	SearchStrainsByEffectName(ctx context.Context, in *SearchStrainsByEffectNameRequest, opts ...grpc.CallOption) (*SearchStrainsResponse, error)
+	GetStrainDescription(ctx context.Context, in *GetStrainDescriptionRequest, opts ...grpc.CallOption) (*GetStrainDescriptionResponse, error)
+	GetStrainFlavors(ctx context.Context, in *GetStrainFlavorsRequest, opts ...grpc.CallOption) (*GetStrainFlavorsResponse, error)
+	GetStrainEffects(ctx context.Context, in *GetStrainEffectsRequest, opts ...grpc.CallOption) (*GetStrainEffectsResponse, error)
+}
+
+type strainServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStrainServiceClient(cc grpc.ClientConnInterface) StrainServiceClient {
+	return &strainServiceClient{cc}
+}
+
+func (c *strainServiceClient) ListAllEffects(ctx context.Context, in *ListAllEffectsRequest, opts ...grpc.CallOption) (*ListAllEffectsResponse, error) {
+	out := new(ListAllEffectsResponse)
+	err := c.cc.Invoke(ctx, "/strainapi.v1.StrainService/ListAllEffects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strainServiceClient) ListAllFlavors(ctx context.Context, in *ListAllFlavorsRequest, opts ...grpc.CallOption) (*ListAllFlavorsResponse, error) {
+	out := new(ListAllFlavorsResponse)
+	err := c.cc.Invoke(ctx, "/strainapi.v1.StrainService/ListAllFlavors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strainServiceClient) ListAllStrains(ctx context.Context, in *ListAllStrainsRequest, opts ...grpc.CallOption) (*ListAllStrainsResponse, error) {
+	out := new(ListAllStrainsResponse)
+	err := c.cc.Invoke(ctx, "/strainapi.v1.StrainService/ListAllStrains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strainServiceClient) StreamAllStrains(ctx context.Context, in *StreamAllStrainsRequest, opts ...grpc.CallOption) (StrainService_StreamAllStrainsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StrainService_ServiceDesc.Streams[0], "/strainapi.v1.StrainService/StreamAllStrains", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &strainServiceStreamAllStrainsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StrainService_StreamAllStrainsClient interface {
+	Recv() (*Strain, error)
+	grpc.ClientStream
+}
+
+type strainServiceStreamAllStrainsClient struct {
+	grpc.ClientStream
+}
+
+func (x *strainServiceStreamAllStrainsClient) Recv() (*Strain, error) {
+	m := new(Strain)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *strainServiceClient) SearchStrainsByName(ctx context.Context, in *SearchStrainsByNameRequest, opts ...grpc.CallOption) (*SearchStrainsResponse, error) {
+	out := new(SearchStrainsResponse)
+	err := c.cc.Invoke(ctx, "/strainapi.v1.StrainService/SearchStrainsByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strainServiceClient) SearchStrainsByRace(ctx context.Context, in *SearchStrainsByRaceRequest, opts ...grpc.CallOption) (*SearchStrainsResponse, error) {
+	out := new(SearchStrainsResponse)
+	err := c.cc.Invoke(ctx, "/strainapi.v1.StrainService/SearchStrainsByRace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strainServiceClient) SearchStrainsByFlavor(ctx context.Context, in *SearchStrainsByFlavorRequest, opts ...grpc.CallOption) (*SearchStrainsResponse, error) {
+	out := new(SearchStrainsResponse)
+	err := c.cc.Invoke(ctx, "/strainapi.v1.StrainService/SearchStrainsByFlavor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strainServiceClient) SearchStrainsByEffectName(ctx context.Context, in *SearchStrainsByEffectNameRequest, opts ...grpc.CallOption) (*SearchStrainsResponse, error) {
+	out := new(SearchStrainsResponse)
+	err := c.cc.Invoke(ctx, "/strainapi.v1.StrainService/SearchStrainsByEffectName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strainServiceClient) GetStrainDescription(ctx context.Context, in *GetStrainDescriptionRequest, opts ...grpc.CallOption) (*GetStrainDescriptionResponse, error) {
+	out := new(GetStrainDescriptionResponse)
+	err := c.cc.Invoke(ctx, "/strainapi.v1.StrainService/GetStrainDescription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strainServiceClient) GetStrainFlavors(ctx context.Context, in *GetStrainFlavorsRequest, opts ...grpc.CallOption) (*GetStrainFlavorsResponse, error) {
+	out := new(GetStrainFlavorsResponse)
+	err := c.cc.Invoke(ctx, "/strainapi.v1.StrainService/GetStrainFlavors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strainServiceClient) GetStrainEffects(ctx context.Context, in *GetStrainEffectsRequest, opts ...grpc.CallOption) (*GetStrainEffectsResponse, error) {
+	out := new(GetStrainEffectsResponse)
+	err := c.cc.Invoke(ctx, "/strainapi.v1.StrainService/GetStrainEffects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StrainServiceServer is the server API for StrainService service.
+// All implementations must embed UnimplementedStrainServiceServer
+// for forward compatibility
+type StrainServiceServer interface {
+	ListAllEffects(context.Context, *ListAllEffectsRequest) (*ListAllEffectsResponse, error)
+	ListAllFlavors(context.Context, *ListAllFlavorsRequest) (*ListAllFlavorsResponse, error)
+	ListAllStrains(context.Context, *ListAllStrainsRequest) (*ListAllStrainsResponse, error)
+	// StreamAllStrains sends every strain, in order of ID, one message at a
+	// time.
+	StreamAllStrains(*StreamAllStrainsRequest, StrainService_StreamAllStrainsServer) error
+	SearchStrainsByName(context.Context, *SearchStrainsByNameRequest) (*SearchStrainsResponse, error)
+	SearchStrainsByRace(context.Context, *SearchStrainsByRaceRequest) (*SearchStrainsResponse, error)
+	SearchStrainsByFlavor(context.Context, *SearchStrainsByFlavorRequest) (*SearchStrainsResponse, error)
+	SearchStrainsByEffectName(context.Context, *SearchStrainsByEffectNameRequest) (*SearchStrainsResponse, error)
+	GetStrainDescription(context.Context, *GetStrainDescriptionRequest) (*GetStrainDescriptionResponse, error)
+	GetStrainFlavors(context.Context, *GetStrainFlavorsRequest) (*GetStrainFlavorsResponse, error)
+	GetStrainEffects(context.Context, *GetStrainEffectsRequest) (*GetStrainEffectsResponse, error)
+	mustEmbedUnimplementedStrainServiceServer()
+}
+
+// UnimplementedStrainServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedStrainServiceServer struct {
+}
+
+func (UnimplementedStrainServiceServer) ListAllEffects(context.Context, *ListAllEffectsRequest) (*ListAllEffectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllEffects not implemented")
+}
+func (UnimplementedStrainServiceServer) ListAllFlavors(context.Context, *ListAllFlavorsRequest) (*ListAllFlavorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllFlavors not implemented")
+}
+func (UnimplementedStrainServiceServer) ListAllStrains(context.Context, *ListAllStrainsRequest) (*ListAllStrainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllStrains not implemented")
+}
+func (UnimplementedStrainServiceServer) StreamAllStrains(*StreamAllStrainsRequest, StrainService_StreamAllStrainsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAllStrains not implemented")
+}
+func (UnimplementedStrainServiceServer) SearchStrainsByName(context.Context, *SearchStrainsByNameRequest) (*SearchStrainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchStrainsByName not implemented")
+}
+func (UnimplementedStrainServiceServer) SearchStrainsByRace(context.Context, *SearchStrainsByRaceRequest) (*SearchStrainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchStrainsByRace not implemented")
+}
+func (UnimplementedStrainServiceServer) SearchStrainsByFlavor(context.Context, *SearchStrainsByFlavorRequest) (*SearchStrainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchStrainsByFlavor not implemented")
+}
+func (UnimplementedStrainServiceServer) SearchStrainsByEffectName(context.Context, *SearchStrainsByEffectNameRequest) (*SearchStrainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchStrainsByEffectName not implemented")
+}
+func (UnimplementedStrainServiceServer) GetStrainDescription(context.Context, *GetStrainDescriptionRequest) (*GetStrainDescriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStrainDescription not implemented")
+}
+func (UnimplementedStrainServiceServer) GetStrainFlavors(context.Context, *GetStrainFlavorsRequest) (*GetStrainFlavorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStrainFlavors not implemented")
+}
+func (UnimplementedStrainServiceServer) GetStrainEffects(context.Context, *GetStrainEffectsRequest) (*GetStrainEffectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStrainEffects not implemented")
+}
+func (UnimplementedStrainServiceServer) mustEmbedUnimplementedStrainServiceServer() {}
+
+// UnsafeStrainServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StrainServiceServer will
+// result in compilation errors.
+type UnsafeStrainServiceServer interface {
+	mustEmbedUnimplementedStrainServiceServer()
+}
+
+func RegisterStrainServiceServer(s grpc.ServiceRegistrar, srv StrainServiceServer) {
+	s.RegisterService(&StrainService_ServiceDesc, srv)
+}
+
+func _StrainService_ListAllEffects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllEffectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrainServiceServer).ListAllEffects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strainapi.v1.StrainService/ListAllEffects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrainServiceServer).ListAllEffects(ctx, req.(*ListAllEffectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrainService_ListAllFlavors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllFlavorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrainServiceServer).ListAllFlavors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strainapi.v1.StrainService/ListAllFlavors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrainServiceServer).ListAllFlavors(ctx, req.(*ListAllFlavorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrainService_ListAllStrains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllStrainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrainServiceServer).ListAllStrains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strainapi.v1.StrainService/ListAllStrains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrainServiceServer).ListAllStrains(ctx, req.(*ListAllStrainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrainService_StreamAllStrains_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamAllStrainsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StrainServiceServer).StreamAllStrains(m, &strainServiceStreamAllStrainsServer{stream})
+}
+
+type StrainService_StreamAllStrainsServer interface {
+	Send(*Strain) error
+	grpc.ServerStream
+}
+
+type strainServiceStreamAllStrainsServer struct {
+	grpc.ServerStream
+}
+
+func (x *strainServiceStreamAllStrainsServer) Send(m *Strain) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StrainService_SearchStrainsByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchStrainsByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrainServiceServer).SearchStrainsByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strainapi.v1.StrainService/SearchStrainsByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrainServiceServer).SearchStrainsByName(ctx, req.(*SearchStrainsByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrainService_SearchStrainsByRace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchStrainsByRaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrainServiceServer).SearchStrainsByRace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strainapi.v1.StrainService/SearchStrainsByRace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrainServiceServer).SearchStrainsByRace(ctx, req.(*SearchStrainsByRaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrainService_SearchStrainsByFlavor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchStrainsByFlavorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrainServiceServer).SearchStrainsByFlavor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strainapi.v1.StrainService/SearchStrainsByFlavor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrainServiceServer).SearchStrainsByFlavor(ctx, req.(*SearchStrainsByFlavorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrainService_SearchStrainsByEffectName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchStrainsByEffectNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrainServiceServer).SearchStrainsByEffectName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strainapi.v1.StrainService/SearchStrainsByEffectName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrainServiceServer).SearchStrainsByEffectName(ctx, req.(*SearchStrainsByEffectNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrainService_GetStrainDescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStrainDescriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrainServiceServer).GetStrainDescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strainapi.v1.StrainService/GetStrainDescription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrainServiceServer).GetStrainDescription(ctx, req.(*GetStrainDescriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrainService_GetStrainFlavors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStrainFlavorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrainServiceServer).GetStrainFlavors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strainapi.v1.StrainService/GetStrainFlavors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrainServiceServer).GetStrainFlavors(ctx, req.(*GetStrainFlavorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrainService_GetStrainEffects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStrainEffectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrainServiceServer).GetStrainEffects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strainapi.v1.StrainService/GetStrainEffects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrainServiceServer).GetStrainEffects(ctx, req.(*GetStrainEffectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StrainService_ServiceDesc is the grpc.ServiceDesc for StrainService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StrainService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "strainapi.v1.StrainService",
+	HandlerType: (*StrainServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAllEffects",
+			Handler:    _StrainService_ListAllEffects_Handler,
+		},
+		{
+			MethodName: "ListAllFlavors",
+			Handler:    _StrainService_ListAllFlavors_Handler,
+		},
+		{
+			MethodName: "ListAllStrains",
+			Handler:    _StrainService_ListAllStrains_Handler,
+		},
+		{
+			MethodName: "SearchStrainsByName",
+			Handler:    _StrainService_SearchStrainsByName_Handler,
+		},
+		{
+			MethodName: "SearchStrainsByRace",
+			Handler:    _StrainService_SearchStrainsByRace_Handler,
+		},
+		{
+			MethodName: "SearchStrainsByFlavor",
+			Handler:    _StrainService_SearchStrainsByFlavor_Handler,
+		},
+		{
+			MethodName: "SearchStrainsByEffectName",
+			Handler:    _StrainService_SearchStrainsByEffectName_Handler,
+		},
+		{
+			MethodName: "GetStrainDescription",
+			Handler:    _StrainService_GetStrainDescription_Handler,
+		},
+		{
+			MethodName: "GetStrainFlavors",
+			Handler:    _StrainService_GetStrainFlavors_Handler,
+		},
+		{
+			MethodName: "GetStrainEffects",
+			Handler:    _StrainService_GetStrainEffects_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamAllStrains",
+			Handler:       _StrainService_StreamAllStrains_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "strainapi.proto",
+}
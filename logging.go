@@ -0,0 +1,245 @@
+package strainapiclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Logger is a structured logger with the same methods as a *slog.Logger
+// from log/slog, so one can be passed to SetLogger as is.  args are
+// alternating keys and values.
+type Logger interface {
+	Info(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// SetLogger sets the Logger every request of the DefaultClient is logged to
+// and returns the previous value.  Requests are not logged if it is nil,
+// which is the default.
+//
+// Each request is logged with its method, path (without the API Key),
+// status, duration and bytes, and failed requests at the error level with
+// their ErrorClass and error.
+func (c *DefaultClient) SetLogger(logger Logger) Logger {
+	current := c.logger
+	c.logger = logger
+	return current
+}
+
+// logRequest logs a request made by simpleHTTPGet.
+func (c *DefaultClient) logRequest(restOfURLPath string, duration time.Duration, body []byte, err error) {
+	if restOfURLPath == "" {
+		restOfURLPath = "/"
+	}
+
+	args := []interface{}{
+		"method", "GET",
+		"path", restOfURLPath,
+		"status", statusOf(err),
+		"duration", duration,
+		"bytes", len(body),
+	}
+
+	if err != nil {
+		args = append(args, "error_class", ErrorClass(err), "error", err.Error())
+		c.logger.Error("strain api request failed", args...)
+		return
+	}
+
+	c.logger.Info("strain api request", args...)
+}
+
+// statusOf returns the HTTP status of a request from its error: 200 when
+// there is none, and 0 when there was no response.
+func statusOf(err error) int {
+	if err == nil {
+		return 200
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode
+	}
+
+	return 0
+}
+
+// The classes of errors returned by ErrorClass.
+const (
+	ErrorClassConnection  = "connection"
+	ErrorClassStatus      = "status"
+	ErrorClassNotFound    = "not_found"
+	ErrorClassDecode      = "decode"
+	ErrorClassCircuitOpen = "circuit_open"
+	ErrorClassOther       = "other"
+)
+
+// ErrorClass sorts an error returned by a Client into a small set of
+// classes for logs and metrics: ErrorClassConnection for a ConnectionError,
+// ErrorClassStatus for a StatusError, ErrorClassNotFound for
+// ErrStrainNotFound and ErrDescriptionNotFound, ErrorClassDecode for a
+// response that could not be decoded, ErrorClassCircuitOpen for
+// ErrCircuitOpen and ErrorClassOther for anything else.
+// It returns an empty string for a nil error.
+func ErrorClass(err error) string {
+	var connectionErr *ConnectionError
+	var statusErr *StatusError
+	var schemaErr *SchemaError
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, ErrStrainNotFound) || errors.Is(err, ErrDescriptionNotFound):
+		return ErrorClassNotFound
+	case errors.Is(err, ErrCircuitOpen):
+		return ErrorClassCircuitOpen
+	case errors.As(err, &connectionErr):
+		return ErrorClassConnection
+	case errors.As(err, &statusErr):
+		return ErrorClassStatus
+	case errors.As(err, &schemaErr) || errors.As(err, &syntaxErr) || errors.As(err, &typeErr):
+		return ErrorClassDecode
+	}
+
+	return ErrorClassOther
+}
+
+// redactedAPIKey replaces the API Key in error messages.
+const redactedAPIKey string = "REDACTED"
+
+// redactedError stands in for an error whose message has the API Key in
+// it, with the key removed.  It unwraps to the error that one wrapped,
+// redacted in turn, so errors.Is and errors.As keep working without any
+// error in the chain giving the key away.
+type redactedError struct {
+	err     error
+	message string
+	apiKey  string
+}
+
+func (e *redactedError) Error() string {
+	return e.message
+}
+
+func (e *redactedError) Unwrap() error {
+	return redactError(errors.Unwrap(e.err), e.apiKey)
+}
+
+// redact removes the API Key from err, which may come from any
+// HandleResourceRequestFunc or Middleware.
+func (c *DefaultClient) redact(err error) error {
+	if c.apiKey == "" {
+		return err
+	}
+	return redactError(err, c.apiKey)
+}
+
+// redactError returns err with apiKey removed from it and from every error
+// it wraps.  A *ConnectionError, *StatusError or *url.Error is copied with
+// the key removed, so it can still be found with errors.As; any other error
+// with the key in it is replaced by a *redactedError.
+func redactError(err error, apiKey string) error {
+	if err == nil || !containsAPIKey(err, apiKey) {
+		return err
+	}
+
+	switch e := err.(type) {
+	case *ConnectionError:
+		return &ConnectionError{Err: redactError(e.Err, apiKey)}
+	case *StatusError:
+		return &StatusError{StatusCode: e.StatusCode, Body: strings.ReplaceAll(e.Body, apiKey, redactedAPIKey)}
+	case *url.Error:
+		return &url.Error{Op: e.Op, URL: strings.ReplaceAll(e.URL, apiKey, redactedAPIKey), Err: redactError(e.Err, apiKey)}
+	}
+
+	return &redactedError{err: err, message: strings.ReplaceAll(err.Error(), apiKey, redactedAPIKey), apiKey: apiKey}
+}
+
+// containsAPIKey reports whether the message of err or of any error it
+// wraps has apiKey in it.
+func containsAPIKey(err error, apiKey string) bool {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if strings.Contains(err.Error(), apiKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactURLError removes the API Key, the first segment of the path, from
+// the URL in a *url.Error, which net/http includes in its error messages.
+func redactURLError(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+
+	if parsedURL, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+		segments := strings.SplitN(strings.TrimPrefix(parsedURL.Path, "/"), "/", 2)
+		segments[0] = redactedAPIKey
+		parsedURL.Path = "/" + strings.Join(segments, "/")
+		parsedURL.RawPath = ""
+		urlErr.URL = parsedURL.String()
+	} else {
+		urlErr.URL = redactedAPIKey
+	}
+
+	return err
+}
+
+// textLogger is the Logger returned by NewTextLogger.
+type textLogger struct {
+	mutex sync.Mutex
+	out   io.Writer
+	now   func() time.Time
+}
+
+// NewTextLogger returns a Logger that writes a line of key=value pairs for
+// every entry to out, like the TextHandler of log/slog, for programs that
+// do not have a structured logger of their own.
+func NewTextLogger(out io.Writer) Logger {
+	return &textLogger{out: out, now: time.Now}
+}
+
+func (l *textLogger) Info(msg string, args ...interface{}) {
+	l.write("INFO", msg, args)
+}
+
+func (l *textLogger) Error(msg string, args ...interface{}) {
+	l.write("ERROR", msg, args)
+}
+
+func (l *textLogger) write(level string, msg string, args []interface{}) {
+	var line strings.Builder
+	fmt.Fprintf(&line, "time=%s level=%s msg=%s", l.now().Format(time.RFC3339Nano), level, textValue(msg))
+
+	for index := 0; index < len(args); index += 2 {
+		key := fmt.Sprint(args[index])
+		value := "!MISSING"
+		if index+1 < len(args) {
+			value = textValue(fmt.Sprint(args[index+1]))
+		}
+		fmt.Fprintf(&line, " %s=%s", key, value)
+	}
+	line.WriteString("\n")
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	_, _ = io.WriteString(l.out, line.String())
+}
+
+// textValue quotes values that would otherwise be ambiguous.
+func textValue(value string) string {
+	if value == "" || strings.ContainsAny(value, " \"=\t\n") {
+		return fmt.Sprintf("%q", value)
+	}
+	return value
+}
@@ -0,0 +1,180 @@
+package strainapiclient_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+type logEntry struct {
+	level  string
+	msg    string
+	fields map[string]interface{}
+}
+
+// recordingLogger is a Logger that keeps every entry.
+type recordingLogger struct {
+	entries []logEntry
+}
+
+func (l *recordingLogger) Info(msg string, args ...interface{}) {
+	l.record("INFO", msg, args)
+}
+
+func (l *recordingLogger) Error(msg string, args ...interface{}) {
+	l.record("ERROR", msg, args)
+}
+
+func (l *recordingLogger) record(level string, msg string, args []interface{}) {
+	fields := make(map[string]interface{})
+	for index := 0; index+1 < len(args); index += 2 {
+		fields[args[index].(string)] = args[index+1]
+	}
+	l.entries = append(l.entries, logEntry{level: level, msg: msg, fields: fields})
+}
+
+func TestSetLoggerLogsEveryRequest(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+	client.Use(strainapiclient.StubMiddleware(strainapiclient.Stub{
+		Path: "/strains/data/desc/2",
+		Err:  &strainapiclient.StatusError{StatusCode: http.StatusServiceUnavailable},
+	}))
+
+	logger := &recordingLogger{}
+	if previous := client.SetLogger(logger); previous != nil {
+		t.Errorf("Expected no Logger by default, got %v", previous)
+	}
+
+	_, _ = client.GetStrainFlavorsByStrainID(1)
+	_, _ = client.GetStrainDescriptionByStrainID(2)
+
+	if len(logger.entries) != 2 {
+		t.Fatalf("Expected an entry per request, got %+v", logger.entries)
+	}
+
+	succeeded := logger.entries[0]
+	if succeeded.level != "INFO" || succeeded.fields["method"] != "GET" || succeeded.fields["path"] != "/strains/data/flavors/1" ||
+		succeeded.fields["status"] != 200 || succeeded.fields["bytes"].(int) == 0 || succeeded.fields["duration"] == nil {
+		t.Errorf("Unexpected entry for a request that succeeded: %+v", succeeded)
+	}
+	if _, found := succeeded.fields["error"]; found {
+		t.Errorf("Expected no error for a request that succeeded, got %+v", succeeded)
+	}
+
+	failed := logger.entries[1]
+	if failed.level != "ERROR" || failed.fields["status"] != http.StatusServiceUnavailable ||
+		failed.fields["error_class"] != strainapiclient.ErrorClassStatus || failed.fields["error"] == nil {
+		t.Errorf("Unexpected entry for a request that failed: %+v", failed)
+	}
+}
+
+func TestErrorsDoNotContainAPIKey(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+	client.SetHandleResourceRequestFunc(func(resourcePath string) ([]byte, error) {
+		return nil, &strainapiclient.ConnectionError{Err: fmt.Errorf("could not reach %s", resourcePath)}
+	})
+
+	var output bytes.Buffer
+	client.SetLogger(strainapiclient.NewTextLogger(&output))
+
+	_, err := client.ListAllStrains()
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if strings.Contains(err.Error(), clienttest.FixtureAPIKey) || strings.Contains(output.String(), clienttest.FixtureAPIKey) {
+		t.Errorf("Expected the API Key to be redacted, got %q and %q", err, output.String())
+	}
+	if !strings.Contains(output.String(), "error_class=connection") {
+		t.Errorf("Expected the error class in the log, got %q", output.String())
+	}
+
+	var connectionErr *strainapiclient.ConnectionError
+	if !errors.As(err, &connectionErr) {
+		t.Errorf("Expected the redacted error to still be a ConnectionError, got %T", err)
+	}
+}
+
+func TestRedactedErrorsDoNotUnwrapToAPIKey(t *testing.T) {
+	errUnreachable := errors.New("unreachable")
+
+	client := clienttest.NewFixtureClient()
+	client.SetHandleResourceRequestFunc(func(resourcePath string) ([]byte, error) {
+		urlErr := &url.Error{Op: "Get", URL: resourcePath, Err: fmt.Errorf("dial %s: %w", resourcePath, errUnreachable)}
+		return nil, fmt.Errorf("request for %s failed: %w", resourcePath, &strainapiclient.ConnectionError{Err: urlErr})
+	})
+
+	_, err := client.ListAllEffects()
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+
+	for wrapped := err; wrapped != nil; wrapped = errors.Unwrap(wrapped) {
+		if strings.Contains(wrapped.Error(), clienttest.FixtureAPIKey) {
+			t.Errorf("Expected no error in the chain to contain the API Key, got %T %q", wrapped, wrapped)
+		}
+	}
+
+	if !errors.Is(err, errUnreachable) {
+		t.Errorf("Expected the redacted error to still wrap the sentinel, got %v", err)
+	}
+	var connectionErr *strainapiclient.ConnectionError
+	if !errors.As(err, &connectionErr) {
+		t.Errorf("Expected the redacted error to still be a ConnectionError, got %v", err)
+	}
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) || strings.Contains(urlErr.URL, clienttest.FixtureAPIKey) || !strings.Contains(urlErr.URL, "/searchdata/effects") {
+		t.Errorf("Expected a *url.Error with the API Key removed from its URL, got %v", urlErr)
+	}
+}
+
+func TestDefaultHandlerErrorsDoNotContainAPIKey(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	client := strainapiclient.NewDefaultClient(clienttest.FixtureAPIKey)
+	client.SetBaseURL(server.URL)
+
+	// The handler itself redacts the URL net/http puts in its errors, so
+	// even the ConnectionError it returns is safe to log.
+	_, err := client.ListAllEffects()
+
+	var connectionErr *strainapiclient.ConnectionError
+	if !errors.As(err, &connectionErr) {
+		t.Fatalf("Expected a ConnectionError, got %v", err)
+	}
+	if strings.Contains(connectionErr.Error(), clienttest.FixtureAPIKey) {
+		t.Errorf("Expected the API Key to be redacted, got %q", connectionErr)
+	}
+	if !strings.Contains(connectionErr.Error(), "/searchdata/effects") {
+		t.Errorf("Expected the rest of the URL to be kept, got %q", connectionErr)
+	}
+}
+
+func TestErrorClass(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected string
+	}{
+		{nil, ""},
+		{&strainapiclient.ConnectionError{Err: errors.New("connection reset")}, strainapiclient.ErrorClassConnection},
+		{fmt.Errorf("wrapped: %w", &strainapiclient.StatusError{StatusCode: http.StatusBadGateway}), strainapiclient.ErrorClassStatus},
+		{strainapiclient.ErrStrainNotFound, strainapiclient.ErrorClassNotFound},
+		{strainapiclient.ErrCircuitOpen, strainapiclient.ErrorClassCircuitOpen},
+		{&strainapiclient.SchemaError{Endpoint: "/strains/search/all"}, strainapiclient.ErrorClassDecode},
+		{errors.New("something else"), strainapiclient.ErrorClassOther},
+	}
+
+	for _, test := range tests {
+		if class := strainapiclient.ErrorClass(test.err); class != test.expected {
+			t.Errorf("%v: expected %q, got %q", test.err, test.expected, class)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package strainapiclient
+
+import (
+	"strings"
+	"time"
+)
+
+// MetricsCollector receives measurements of how the API is used.  Set one on
+// a DefaultClient with SetMetricsCollector, and on a ResponseCache or
+// RateLimiter with theirs.  endpoint is the name of the Client method the
+// request was made for, such as "SearchStrainsByFlavor", or "GetResource" for
+// any other path.  Implementations must be safe for concurrent use.
+type MetricsCollector interface {
+	// ObserveRequest is called after every request with how long it took.
+	ObserveRequest(endpoint string, duration time.Duration)
+	// ObserveError is called for every error, with its ErrorClass, whether
+	// the request failed or its response could not be used.
+	ObserveError(endpoint string, errorClass string)
+	// ObserveCacheLookup is called for every request a ResponseCache sees.
+	ObserveCacheLookup(endpoint string, hit bool)
+	// ObserveRateLimitWait is called for every request a RateLimiter held
+	// back, with how long it waited.
+	ObserveRateLimitWait(endpoint string, wait time.Duration)
+}
+
+// SetMetricsCollector sets the MetricsCollector the DefaultClient reports
+// its requests and errors to and returns the previous value.  Nothing is
+// collected if it is nil, which is the default.
+func (c *DefaultClient) SetMetricsCollector(collector MetricsCollector) MetricsCollector {
+	current := c.metrics
+	c.metrics = collector
+	return current
+}
+
+// observeError reports err, if any, to the MetricsCollector of the
+// DefaultClient.
+func (c *DefaultClient) observeError(endpoint string, err error) {
+	if c.metrics != nil && err != nil {
+		c.metrics.ObserveError(endpoint, ErrorClass(err))
+	}
+}
+
+// Names of the endpoints that are not called by one of the methods of the
+// Client interface.
+const (
+	endpointCanConnect  = "CanConnect"
+	endpointGetResource = "GetResource"
+)
+
+// endpointForPath returns the name of the endpoint of a resource path
+// (the part of the URL after the API Key), keeping metric labels to a
+// fixed set however many strains and search terms are requested.
+func endpointForPath(restOfURLPath string) string {
+	dataPath := strainDataBasePath + "/"
+	searchPath := strainSearchBasePath + "/"
+
+	switch {
+	case restOfURLPath == "" || restOfURLPath == "/":
+		return endpointCanConnect
+	case restOfURLPath == "/searchdata/effects":
+		return endpointListAllEffects
+	case restOfURLPath == "/searchdata/flavors":
+		return endpointListAllFlavors
+	case restOfURLPath == searchPath+"all":
+		return endpointListAllStrains
+	case strings.HasPrefix(restOfURLPath, searchPath+"name/"):
+		return endpointSearchStrainsByName
+	case strings.HasPrefix(restOfURLPath, searchPath+"race/"):
+		return endpointSearchStrainsByRace
+	case strings.HasPrefix(restOfURLPath, searchPath+"flavor/"):
+		return endpointSearchStrainsByFlavor
+	case strings.HasPrefix(restOfURLPath, searchPath+"effect/"):
+		return endpointSearchStrainsByEffectName
+	case strings.HasPrefix(restOfURLPath, dataPath+"desc/"):
+		return endpointGetStrainDescriptionByStrainID
+	case strings.HasPrefix(restOfURLPath, dataPath+"flavors/"):
+		return endpointGetStrainFlavorsByStrainID
+	case strings.HasPrefix(restOfURLPath, dataPath+"effects/"):
+		return endpointGetStrainEffectsByStrainID
+	}
+
+	return endpointGetResource
+}
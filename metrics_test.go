@@ -0,0 +1,75 @@
+package strainapiclient_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+func TestPrometheusCollector(t *testing.T) {
+	collector := strainapiclient.NewPrometheusCollector(0.1, 1)
+
+	cache := strainapiclient.NewResponseCache(time.Minute)
+	cache.SetMetricsCollector(collector)
+
+	limiter := strainapiclient.NewRateLimiter(100, 1)
+	limiter.SetMetricsCollector(collector)
+
+	client := clienttest.NewFixtureClient()
+	client.SetMetricsCollector(collector)
+	client.Use(
+		strainapiclient.CachingMiddleware(cache),
+		strainapiclient.RateLimitMiddleware(limiter),
+		strainapiclient.StubMiddleware(strainapiclient.Stub{
+			Path: "/strains/data/flavors/2",
+			Err:  &strainapiclient.ConnectionError{Err: errors.New("connection reset")},
+		}),
+	)
+
+	_, _ = client.GetStrainFlavorsByStrainID(1)
+	_, _ = client.GetStrainFlavorsByStrainID(1)
+	_, _ = client.GetStrainFlavorsByStrainID(2)
+	_, _ = client.GetStrainFlavorsByStrainID(3)
+	_, _ = client.GetStrainDescriptionByStrainID(clienttest.UnknownStrainID)
+
+	server := httptest.NewServer(collector)
+	defer server.Close()
+
+	response, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	body, _ := ioutil.ReadAll(response.Body)
+
+	if !strings.HasPrefix(response.Header.Get("Content-Type"), "text/plain; version=0.0.4") {
+		t.Errorf("Expected the text exposition format, got %q", response.Header.Get("Content-Type"))
+	}
+
+	for _, expected := range []string{
+		"# TYPE strainapi_client_requests_total counter",
+		`strainapi_client_requests_total{endpoint="GetStrainFlavorsByStrainID"} 4`,
+		"# TYPE strainapi_client_request_duration_seconds histogram",
+		`strainapi_client_request_duration_seconds_bucket{endpoint="GetStrainFlavorsByStrainID",le="+Inf"} 4`,
+		`strainapi_client_request_duration_seconds_count{endpoint="GetStrainDescriptionByStrainID"} 1`,
+		`strainapi_client_errors_total{endpoint="GetStrainFlavorsByStrainID",class="connection"} 1`,
+		`strainapi_client_cache_hits_total{endpoint="GetStrainFlavorsByStrainID"} 1`,
+		`strainapi_client_cache_misses_total{endpoint="GetStrainFlavorsByStrainID"} 3`,
+		`strainapi_client_rate_limit_waits_total{endpoint="GetStrainFlavorsByStrainID"}`,
+	} {
+		if !strings.Contains(string(body), expected+"\n") && !strings.Contains(string(body), expected+" ") {
+			t.Errorf("Expected %q in the metrics, got:\n%s", expected, body)
+		}
+	}
+
+	if !strings.Contains(string(body), `endpoint="GetStrainDescriptionByStrainID",class="not_found"} 1`) {
+		t.Errorf("Expected the missing description to be counted, got:\n%s", body)
+	}
+}
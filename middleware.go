@@ -0,0 +1,132 @@
+package strainapiclient
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"path"
+	"time"
+)
+
+// Middleware wraps a HandleResourceRequestFunc with behavior of its own,
+// calling next to carry on with the request.  Add them to a DefaultClient
+// with Use rather than wrapping the handler by hand, so that several
+// libraries can each add their own.
+type Middleware func(next HandleResourceRequestFunc) HandleResourceRequestFunc
+
+// Chain composes middlewares into one, the first being the outermost.
+func Chain(middlewares ...Middleware) Middleware {
+	return func(next HandleResourceRequestFunc) HandleResourceRequestFunc {
+		for index := len(middlewares) - 1; index >= 0; index-- {
+			next = middlewares[index](next)
+		}
+		return next
+	}
+}
+
+// LoggingMiddleware logs every request, with how long it took and its error
+// if it failed, leaving the API Key out of both.
+func LoggingMiddleware(logger *log.Logger) Middleware {
+	return TimingMiddleware(func(resourcePath string, duration time.Duration, err error) {
+		if err != nil {
+			if apiKey := apiKeyOfResourcePath(resourcePath); apiKey != "" {
+				err = redactError(err, apiKey)
+			}
+			logger.Printf("GET %s failed after %s: %s", resourcePathWithoutAPIKey(resourcePath), duration, err)
+			return
+		}
+		logger.Printf("GET %s took %s", resourcePathWithoutAPIKey(resourcePath), duration)
+	})
+}
+
+// TimingMiddleware calls observe after every request with how long it took
+// and its error, if any.
+func TimingMiddleware(observe func(resourcePath string, duration time.Duration, err error)) Middleware {
+	return func(next HandleResourceRequestFunc) HandleResourceRequestFunc {
+		return func(resourcePath string) ([]byte, error) {
+			start := time.Now()
+			body, err := next(resourcePath)
+			observe(resourcePath, time.Since(start), err)
+			return body, err
+		}
+	}
+}
+
+// RetryMiddleware makes up to maxAttempts attempts at requests that fail with
+// a ConnectionError or a StatusError for a 429 or 5xx status, waiting
+// backoff before the second attempt and twice as long before every further
+// one.
+//
+// The waits block the call that made the request, and a
+// HandleResourceRequestFunc has no context to cancel them with, so a
+// request may take up to backoff * (2^(maxAttempts-1) - 1) longer than its
+// attempts do.  Keep both small for calls made while serving a request.
+func RetryMiddleware(maxAttempts int, backoff time.Duration) Middleware {
+	return func(next HandleResourceRequestFunc) HandleResourceRequestFunc {
+		return func(resourcePath string) ([]byte, error) {
+			wait := backoff
+			for attempt := 1; ; attempt++ {
+				body, err := next(resourcePath)
+				if err == nil || attempt >= maxAttempts || !isRetryable(err) {
+					return body, err
+				}
+
+				time.Sleep(wait)
+				wait *= 2
+			}
+		}
+	}
+}
+
+// isRetryable reports whether a failed request may succeed if it is made
+// again.
+func isRetryable(err error) bool {
+	var connectionErr *ConnectionError
+	if errors.As(err, &connectionErr) {
+		return true
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= 500
+	}
+
+	return false
+}
+
+// CachingMiddleware answers requests from cache when it can; see
+// ResponseCache.Wrap.
+func CachingMiddleware(cache *ResponseCache) Middleware {
+	return cache.Wrap
+}
+
+// Stub is a canned response for StubMiddleware.
+type Stub struct {
+	// Path is the resource path after the API Key, such as
+	// "/strains/data/flavors/1".  It may use the patterns of path.Match,
+	// such as "/strains/data/flavors/*".
+	Path string
+	Body []byte
+	Err  error
+}
+
+// StubMiddleware answers the requests whose path matches one of stubs with
+// its response, in the order given, and passes every other request on.
+func StubMiddleware(stubs ...Stub) Middleware {
+	return func(next HandleResourceRequestFunc) HandleResourceRequestFunc {
+		return func(resourcePath string) ([]byte, error) {
+			requestedPath := resourcePathWithoutAPIKey(resourcePath)
+
+			for _, stub := range stubs {
+				if matched, _ := path.Match(stub.Path, requestedPath); matched {
+					if stub.Err != nil {
+						return make([]byte, 0), stub.Err
+					}
+					return stub.Body, nil
+				}
+			}
+
+			return next(resourcePath)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package strainapiclient_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+func recordingMiddleware(name string, calls *[]string) strainapiclient.Middleware {
+	return func(next strainapiclient.HandleResourceRequestFunc) strainapiclient.HandleResourceRequestFunc {
+		return func(resourcePath string) ([]byte, error) {
+			*calls = append(*calls, name)
+			return next(resourcePath)
+		}
+	}
+}
+
+func TestUseComposesMiddlewaresInOrder(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+
+	calls := make([]string, 0)
+	client.Use(recordingMiddleware("first", &calls), recordingMiddleware("second", &calls))
+	client.Use(recordingMiddleware("third", &calls))
+
+	// Replacing the handler, as a library restoring the handler it saw
+	// would, keeps the middlewares.
+	handler := client.SetHandleResourceRequestFunc(nil)
+	client.SetHandleResourceRequestFunc(func(resourcePath string) ([]byte, error) {
+		calls = append(calls, "handler")
+		return handler(resourcePath)
+	})
+
+	if _, err := client.ListAllFlavors(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"first", "second", "third", "handler"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected the request to pass through %v, got %v", expected, calls)
+	}
+}
+
+func TestRetryMiddleware(t *testing.T) {
+	tests := []struct {
+		err              error
+		expectedAttempts int
+	}{
+		{&strainapiclient.ConnectionError{Err: errors.New("connection reset")}, 3},
+		{&strainapiclient.StatusError{StatusCode: http.StatusBadGateway}, 3},
+		{&strainapiclient.StatusError{StatusCode: http.StatusNotFound}, 1},
+		{errors.New("something else"), 1},
+	}
+
+	for _, test := range tests {
+		attempts := 0
+		handler := strainapiclient.RetryMiddleware(3, time.Millisecond)(func(resourcePath string) ([]byte, error) {
+			attempts++
+			return make([]byte, 0), test.err
+		})
+
+		if _, err := handler("path"); err != test.err {
+			t.Errorf("Expected the error of the last attempt, got %v", err)
+		}
+		if attempts != test.expectedAttempts {
+			t.Errorf("%v: expected %d attempts, got %d", test.err, test.expectedAttempts, attempts)
+		}
+	}
+
+	attempts := 0
+	handler := strainapiclient.RetryMiddleware(3, time.Millisecond)(func(resourcePath string) ([]byte, error) {
+		attempts++
+		if attempts < 2 {
+			return make([]byte, 0), &strainapiclient.ConnectionError{Err: errors.New("connection reset")}
+		}
+		return []byte("ok"), nil
+	})
+
+	if body, err := handler("path"); err != nil || string(body) != "ok" || attempts != 2 {
+		t.Errorf("Expected to succeed on the second attempt, got %q, %v after %d attempts", body, err, attempts)
+	}
+}
+
+func TestStubMiddleware(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+	client.Use(strainapiclient.StubMiddleware(
+		strainapiclient.Stub{Path: "/strains/data/flavors/1", Body: []byte(`["Snot","Tar"]`)},
+		strainapiclient.Stub{Path: "/strains/data/desc/*", Err: errors.New("stubbed")},
+	))
+
+	flavors, err := client.GetStrainFlavorsByStrainID(1)
+	if err != nil || !reflect.DeepEqual(flavors, []strainapiclient.Flavor{"Snot", "Tar"}) {
+		t.Errorf("Expected the stubbed flavors, got %v, %v", flavors, err)
+	}
+
+	if _, err := client.GetStrainDescriptionByStrainID(3); err == nil || !strings.Contains(err.Error(), "stubbed") {
+		t.Errorf("Expected the stubbed error for any description, got %v", err)
+	}
+
+	expected, _ := clienttest.KnownDataset().StrainByID(3)
+	if flavors, err := client.GetStrainFlavorsByStrainID(3); err != nil || !reflect.DeepEqual(flavors, expected.Flavors) {
+		t.Errorf("Expected the requests that are not stubbed to reach the handler, got %v, %v", flavors, err)
+	}
+}
+
+func TestLoggingMiddlewareLeavesOutAPIKey(t *testing.T) {
+	var output bytes.Buffer
+
+	client := clienttest.NewFixtureClient()
+	client.Use(strainapiclient.LoggingMiddleware(log.New(&output, "", 0)))
+
+	_, _ = client.ListAllEffects()
+	_, _ = client.SearchStrainsByName("nothing here")
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "GET /searchdata/effects took ") {
+		t.Errorf("Expected a line per request, got %q", output.String())
+	}
+	if strings.Contains(output.String(), clienttest.FixtureAPIKey) {
+		t.Errorf("Expected the API Key to be left out of the log, got %q", output.String())
+	}
+
+	output.Reset()
+	client.SetHandleResourceRequestFunc(func(resourcePath string) ([]byte, error) {
+		return nil, &strainapiclient.ConnectionError{Err: fmt.Errorf("could not reach %s", resourcePath)}
+	})
+	_, _ = client.ListAllEffects()
+
+	if !strings.Contains(output.String(), "failed after") || strings.Contains(output.String(), clienttest.FixtureAPIKey) {
+		t.Errorf("Expected the failure to be logged without the API Key, got %q", output.String())
+	}
+}
+
+func TestCachingMiddleware(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+
+	calls := 0
+	client.Use(
+		strainapiclient.CachingMiddleware(strainapiclient.NewResponseCache(time.Minute)),
+		func(next strainapiclient.HandleResourceRequestFunc) strainapiclient.HandleResourceRequestFunc {
+			return func(resourcePath string) ([]byte, error) {
+				calls++
+				return next(resourcePath)
+			}
+		},
+	)
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.ListAllStrains(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected the cache to answer after the first request, got %d requests", calls)
+	}
+}
@@ -0,0 +1,239 @@
+package strainapiclient
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Fields of a Strain that a Correction can apply to.
+const (
+	FieldName        = "name"
+	FieldRace        = "race"
+	FieldDescription = "description"
+	FieldFlavors     = "flavors"
+	FieldEffects     = "effects"
+)
+
+// Correction is a change NormalizeStrain made to a field of a strain.
+type Correction struct {
+	StrainID int
+	Field    string
+	Reason   string
+	Before   string
+	After    string
+}
+
+func (c Correction) String() string {
+	return fmt.Sprintf("strain %d %s: %s (%q -> %q)", c.StrainID, c.Field, c.Reason, c.Before, c.After)
+}
+
+// NormalizedStrain is a Strain in canonical form, with a slug of its name.
+type NormalizedStrain struct {
+	Strain
+	Slug string `json:"slug"`
+}
+
+// NormalizeStrain returns strain in canonical form along with every
+// correction that was needed to get there:
+//
+//   - names and descriptions are in Unicode NFC, without surrounding or
+//     repeated spaces
+//   - a description of "null" is empty
+//   - races and effect types are normalized with ParseRace and
+//     ParseEffectType
+//   - flavors and effects written all in one case are capitalized like
+//     "Tree Fruit", and are without duplicates and sorted
+//   - nil flavors and effects are empty
+//
+// Sorting and replacing nil are not reported, as they do not change any
+// value.  The slug is made from the name with Slug, or from the ID if the
+// name has no letters or digits.
+func NormalizeStrain(strain Strain) (NormalizedStrain, []Correction) {
+	corrections := make([]Correction, 0)
+	correct := func(field string, reason string, before string, after string) {
+		corrections = append(corrections, Correction{StrainID: strain.ID, Field: field, Reason: reason, Before: before, After: after})
+	}
+
+	normalized := Strain{ID: strain.ID}
+
+	normalized.Name = normalizeText(FieldName, strain.Name, correct)
+	normalized.Description = normalizeText(FieldDescription, strain.Description, correct)
+	if strings.EqualFold(normalized.Description, "null") {
+		correct(FieldDescription, "replaced null", normalized.Description, "")
+		normalized.Description = ""
+	}
+
+	normalized.Race = strain.Race
+	if race, err := ParseRace(string(strain.Race)); err == nil && race != strain.Race {
+		correct(FieldRace, "normalized race", string(strain.Race), string(race))
+		normalized.Race = race
+	}
+
+	normalized.Flavors = make([]Flavor, 0, len(strain.Flavors))
+	for _, flavor := range normalizeTerms(FieldFlavors, flavorNames(strain.Flavors), correct) {
+		normalized.Flavors = append(normalized.Flavors, Flavor(flavor))
+	}
+
+	normalized.Effects = make(map[EffectType][]string, len(strain.Effects))
+	for _, effectType := range sortedEffectTypes(strain.Effects) {
+		canonicalType := effectType
+		if parsed, err := ParseEffectType(string(effectType)); err == nil && parsed != effectType {
+			correct(FieldEffects, "normalized effect type", string(effectType), string(parsed))
+			canonicalType = parsed
+		}
+
+		// Effect types that are the same once normalized are merged.
+		effects := append(normalized.Effects[canonicalType], strain.Effects[effectType]...)
+		normalized.Effects[canonicalType] = effects
+	}
+	for _, effectType := range sortedEffectTypes(normalized.Effects) {
+		normalized.Effects[effectType] = normalizeTerms(FieldEffects, normalized.Effects[effectType], correct)
+	}
+
+	slug := Slug(normalized.Name)
+	if slug == "" {
+		slug = "strain-" + strconv.Itoa(strain.ID)
+	}
+
+	return NormalizedStrain{Strain: normalized, Slug: slug}, corrections
+}
+
+// NormalizeStrains normalizes each strain with NormalizeStrain, returning
+// them in order of ID with all of their corrections.
+func NormalizeStrains(strains []Strain) ([]NormalizedStrain, []Correction) {
+	normalized := make([]NormalizedStrain, 0, len(strains))
+	corrections := make([]Correction, 0)
+
+	for _, strain := range strains {
+		normalizedStrain, strainCorrections := NormalizeStrain(strain)
+		normalized = append(normalized, normalizedStrain)
+		corrections = append(corrections, strainCorrections...)
+	}
+
+	sort.SliceStable(normalized, func(i, j int) bool { return normalized[i].ID < normalized[j].ID })
+	sort.SliceStable(corrections, func(i, j int) bool { return corrections[i].StrainID < corrections[j].StrainID })
+
+	return normalized, corrections
+}
+
+// Slug returns name in lower case with accents and apostrophes removed and
+// every run of other characters than letters and digits replaced with a
+// hyphen, so "Crème Brûlée #2" is "creme-brulee-2".
+func Slug(name string) string {
+	var slug strings.Builder
+	hyphen := false
+
+	for _, r := range norm.NFD.String(name) {
+		switch {
+		case unicode.Is(unicode.Mn, r), r == '\'', r == '’':
+			// An accent separated from its letter, or an apostrophe.
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if hyphen && slug.Len() > 0 {
+				slug.WriteByte('-')
+			}
+			hyphen = false
+			slug.WriteRune(unicode.ToLower(r))
+		default:
+			hyphen = true
+		}
+	}
+
+	return slug.String()
+}
+
+// normalizeText returns value in Unicode NFC and without surrounding or
+// repeated spaces, reporting each change with correct.
+func normalizeText(field string, value string, correct func(field string, reason string, before string, after string)) string {
+	composed := norm.NFC.String(value)
+	if composed != value {
+		correct(field, "normalized Unicode", value, composed)
+	}
+
+	spaced := strings.Join(strings.Fields(composed), " ")
+	if spaced != composed {
+		correct(field, "removed extra spaces", composed, spaced)
+	}
+
+	return spaced
+}
+
+// normalizeTerms returns terms with normalizeText, capitalized with
+// capitalizeTerm, without duplicates and sorted, reporting each change
+// with correct.
+func normalizeTerms(field string, terms []string, correct func(field string, reason string, before string, after string)) []string {
+	seen := make(map[string]bool, len(terms))
+	normalized := make([]string, 0, len(terms))
+
+	for _, term := range terms {
+		text := normalizeText(field, term, correct)
+
+		capitalized := capitalizeTerm(text)
+		if capitalized != text {
+			correct(field, "normalized capitalization", text, capitalized)
+		}
+
+		key := strings.ToLower(capitalized)
+		if seen[key] {
+			correct(field, "removed duplicate", term, "")
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, capitalized)
+	}
+
+	sort.Strings(normalized)
+	return normalized
+}
+
+// lowerCaseWords are not capitalized by capitalizeTerm, unless they start
+// the term, as in "Lack of Appetite".
+var lowerCaseWords = map[string]bool{"a": true, "and": true, "of": true, "the": true}
+
+// capitalizeTerm capitalizes a term written all in lower or all in upper
+// case the way the API writes flavors and effects, like "Tree Fruit".
+// Terms in mixed case are already capitalized and are left as they are.
+func capitalizeTerm(term string) string {
+	if term != strings.ToLower(term) && term != strings.ToUpper(term) {
+		return term
+	}
+
+	words := strings.Split(strings.ToLower(term), " ")
+	for index, word := range words {
+		if index > 0 && lowerCaseWords[word] {
+			continue
+		}
+
+		runes := []rune(word)
+		if len(runes) > 0 {
+			runes[0] = unicode.ToUpper(runes[0])
+		}
+		words[index] = string(runes)
+	}
+
+	return strings.Join(words, " ")
+}
+
+func flavorNames(flavors []Flavor) []string {
+	names := make([]string, len(flavors))
+	for index, flavor := range flavors {
+		names[index] = string(flavor)
+	}
+	return names
+}
+
+// sortedEffectTypes returns the keys of effects in order, so corrections
+// are reported in the same order every time.
+func sortedEffectTypes(effects map[EffectType][]string) []EffectType {
+	effectTypes := make([]EffectType, 0, len(effects))
+	for effectType := range effects {
+		effectTypes = append(effectTypes, effectType)
+	}
+
+	sort.Slice(effectTypes, func(i, j int) bool { return effectTypes[i] < effectTypes[j] })
+	return effectTypes
+}
@@ -0,0 +1,108 @@
+package strainapiclient_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+func TestNormalizeStrain(t *testing.T) {
+	strain := strainapiclient.Strain{
+		ID:          7,
+		Name:        "  Crème   Brûlée ",
+		Description: "null",
+		Race:        "Sativa",
+		Flavors:     []strainapiclient.Flavor{"sweet", "Earthy", "EARTHY", " Tree Fruit"},
+		Effects: map[strainapiclient.EffectType][]string{
+			"Positive": {"happy"},
+			"positive": {"Happy", "Relaxed"},
+			"medical":  {"lack of appetite"},
+		},
+	}
+
+	normalized, corrections := strainapiclient.NormalizeStrain(strain)
+
+	expected := strainapiclient.NormalizedStrain{
+		Strain: strainapiclient.Strain{
+			ID:      7,
+			Name:    "Crème Brûlée",
+			Race:    strainapiclient.RaceSativa,
+			Flavors: []strainapiclient.Flavor{"Earthy", "Sweet", "Tree Fruit"},
+			Effects: map[strainapiclient.EffectType][]string{
+				strainapiclient.EffectTypePositive: {"Happy", "Relaxed"},
+				strainapiclient.EffectTypeMedical:  {"Lack of Appetite"},
+			},
+		},
+		Slug: "creme-brulee",
+	}
+	if !reflect.DeepEqual(normalized, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, normalized)
+	}
+
+	reasons := make(map[string]int)
+	for _, correction := range corrections {
+		if correction.StrainID != 7 {
+			t.Errorf("Expected corrections for strain 7, got %v", correction)
+		}
+		reasons[correction.Field+": "+correction.Reason]++
+	}
+	expectedReasons := map[string]int{
+		"name: normalized Unicode":           1,
+		"name: removed extra spaces":         1,
+		"description: replaced null":         1,
+		"race: normalized race":              1,
+		"flavors: normalized capitalization": 2,
+		"flavors: removed extra spaces":      1,
+		"flavors: removed duplicate":         1,
+		"effects: normalized effect type":    1,
+		"effects: normalized capitalization": 2,
+		"effects: removed duplicate":         1,
+	}
+	if !reflect.DeepEqual(reasons, expectedReasons) {
+		t.Errorf("Expected corrections %v, got %v", expectedReasons, corrections)
+	}
+}
+
+func TestNormalizeStrainsKnownDataset(t *testing.T) {
+	dataset := clienttest.KnownDataset()
+	strains := make([]strainapiclient.Strain, 0, len(dataset.Strains))
+	for index := len(dataset.Strains) - 1; index >= 0; index-- {
+		strains = append(strains, dataset.Strains[index])
+	}
+
+	normalized, corrections := strainapiclient.NormalizeStrains(strains)
+	if len(corrections) != 0 {
+		t.Errorf("Expected the known dataset to need no corrections, got %v", corrections)
+	}
+	for index, strain := range normalized {
+		if strain.ID != dataset.Strains[index].ID || strain.Name != dataset.Strains[index].Name {
+			t.Errorf("Expected the strains in order of ID, got %+v at %d", strain, index)
+		}
+	}
+	if normalized[4].Slug != "sour-diesel" {
+		t.Errorf("Expected the slug sour-diesel, got %q", normalized[4].Slug)
+	}
+}
+
+func TestSlug(t *testing.T) {
+	tests := map[string]string{
+		"Sour Diesel":                    "sour-diesel",
+		"  Girl Scout Cookies #4":        "girl-scout-cookies-4",
+		"Jack's Cleaner 2":               "jacks-cleaner-2",
+		"Crème Brûlée":                   "creme-brulee",
+		"Cre\u0300me Bru\u0302le\u0301e": "creme-brulee",
+		"Señor Čokoláda":                 "senor-cokolada",
+		"Ǎǎ Ǧ Ǒ":                         "aa-g-o",
+		"Phở Việt":                       "pho-viet",
+		"Ἀθῆνα":                          "αθηνα",
+		"!!!":                            "",
+	}
+
+	for name, expected := range tests {
+		if slug := strainapiclient.Slug(name); slug != expected {
+			t.Errorf("Slug(%q): expected %q, got %q", name, expected, slug)
+		}
+	}
+}
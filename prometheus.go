@@ -0,0 +1,223 @@
+package strainapiclient
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultLatencyBuckets are the upper bounds, in seconds, of the buckets of
+// the request latency histogram of a PrometheusCollector.
+var DefaultLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// PrometheusCollector is a MetricsCollector that keeps its measurements in
+// memory and serves them in the Prometheus text exposition format.  It is
+// an http.Handler, so mount it at /metrics.  It is safe for concurrent use.
+type PrometheusCollector struct {
+	mutex          sync.Mutex
+	buckets        []float64
+	latencies      map[string]*histogram
+	errors         map[[2]string]uint64
+	cacheHits      map[string]uint64
+	cacheMisses    map[string]uint64
+	rateLimitWaits map[string]*waitTotal
+}
+
+type histogram struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+type waitTotal struct {
+	count   uint64
+	seconds float64
+}
+
+// NewPrometheusCollector creates a PrometheusCollector whose latency
+// histogram has buckets, in seconds, or DefaultLatencyBuckets if there are
+// none.
+func NewPrometheusCollector(buckets ...float64) *PrometheusCollector {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+
+	sortedBuckets := append([]float64(nil), buckets...)
+	sort.Float64s(sortedBuckets)
+
+	return &PrometheusCollector{
+		buckets:        sortedBuckets,
+		latencies:      make(map[string]*histogram),
+		errors:         make(map[[2]string]uint64),
+		cacheHits:      make(map[string]uint64),
+		cacheMisses:    make(map[string]uint64),
+		rateLimitWaits: make(map[string]*waitTotal),
+	}
+}
+
+// ObserveRequest counts the request and adds its duration to the latency
+// histogram of endpoint.
+func (p *PrometheusCollector) ObserveRequest(endpoint string, duration time.Duration) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	h, found := p.latencies[endpoint]
+	if !found {
+		h = &histogram{counts: make([]uint64, len(p.buckets))}
+		p.latencies[endpoint] = h
+	}
+
+	seconds := duration.Seconds()
+	for index, upperBound := range p.buckets {
+		if seconds <= upperBound {
+			h.counts[index]++
+		}
+	}
+	h.count++
+	h.sum += seconds
+}
+
+// ObserveError counts an error of errorClass for endpoint.
+func (p *PrometheusCollector) ObserveError(endpoint string, errorClass string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.errors[[2]string{endpoint, errorClass}]++
+}
+
+// ObserveCacheLookup counts a cache hit or miss for endpoint.
+func (p *PrometheusCollector) ObserveCacheLookup(endpoint string, hit bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if hit {
+		p.cacheHits[endpoint]++
+	} else {
+		p.cacheMisses[endpoint]++
+	}
+}
+
+// ObserveRateLimitWait counts a request held back by a RateLimiter and adds
+// how long it waited.
+func (p *PrometheusCollector) ObserveRateLimitWait(endpoint string, wait time.Duration) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	total, found := p.rateLimitWaits[endpoint]
+	if !found {
+		total = &waitTotal{}
+		p.rateLimitWaits[endpoint] = total
+	}
+	total.count++
+	total.seconds += wait.Seconds()
+}
+
+// ServeHTTP writes every metric in the Prometheus text exposition format.
+func (p *PrometheusCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = p.WriteMetrics(w)
+}
+
+// WriteMetrics writes every metric to out in the Prometheus text exposition
+// format, in the same order every time.
+func (p *PrometheusCollector) WriteMetrics(out io.Writer) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	writer := bufio.NewWriter(out)
+
+	writeHeader(writer, "strainapi_client_requests_total", "counter", "Requests made to The Strain API.")
+	for _, endpoint := range sortedHistogramKeys(p.latencies) {
+		fmt.Fprintf(writer, "strainapi_client_requests_total{endpoint=%s} %d\n", labelValue(endpoint), p.latencies[endpoint].count)
+	}
+
+	writeHeader(writer, "strainapi_client_request_duration_seconds", "histogram", "How long requests to The Strain API took.")
+	for _, endpoint := range sortedHistogramKeys(p.latencies) {
+		h := p.latencies[endpoint]
+		for index, upperBound := range p.buckets {
+			fmt.Fprintf(writer, "strainapi_client_request_duration_seconds_bucket{endpoint=%s,le=%s} %d\n",
+				labelValue(endpoint), labelValue(formatFloat(upperBound)), h.counts[index])
+		}
+		fmt.Fprintf(writer, "strainapi_client_request_duration_seconds_bucket{endpoint=%s,le=\"+Inf\"} %d\n", labelValue(endpoint), h.count)
+		fmt.Fprintf(writer, "strainapi_client_request_duration_seconds_sum{endpoint=%s} %s\n", labelValue(endpoint), formatFloat(h.sum))
+		fmt.Fprintf(writer, "strainapi_client_request_duration_seconds_count{endpoint=%s} %d\n", labelValue(endpoint), h.count)
+	}
+
+	writeHeader(writer, "strainapi_client_errors_total", "counter", "Errors from The Strain API by class.")
+	errorKeys := make([][2]string, 0, len(p.errors))
+	for key := range p.errors {
+		errorKeys = append(errorKeys, key)
+	}
+	sort.Slice(errorKeys, func(i, j int) bool {
+		if errorKeys[i][0] != errorKeys[j][0] {
+			return errorKeys[i][0] < errorKeys[j][0]
+		}
+		return errorKeys[i][1] < errorKeys[j][1]
+	})
+	for _, key := range errorKeys {
+		fmt.Fprintf(writer, "strainapi_client_errors_total{endpoint=%s,class=%s} %d\n", labelValue(key[0]), labelValue(key[1]), p.errors[key])
+	}
+
+	writeHeader(writer, "strainapi_client_cache_hits_total", "counter", "Requests answered from a ResponseCache.")
+	writeCounters(writer, "strainapi_client_cache_hits_total", p.cacheHits)
+
+	writeHeader(writer, "strainapi_client_cache_misses_total", "counter", "Requests a ResponseCache could not answer.")
+	writeCounters(writer, "strainapi_client_cache_misses_total", p.cacheMisses)
+
+	writeHeader(writer, "strainapi_client_rate_limit_waits_total", "counter", "Requests held back by a RateLimiter.")
+	waitKeys := make([]string, 0, len(p.rateLimitWaits))
+	for endpoint := range p.rateLimitWaits {
+		waitKeys = append(waitKeys, endpoint)
+	}
+	sort.Strings(waitKeys)
+	for _, endpoint := range waitKeys {
+		fmt.Fprintf(writer, "strainapi_client_rate_limit_waits_total{endpoint=%s} %d\n", labelValue(endpoint), p.rateLimitWaits[endpoint].count)
+	}
+
+	writeHeader(writer, "strainapi_client_rate_limit_wait_seconds_total", "counter", "How long requests were held back by a RateLimiter.")
+	for _, endpoint := range waitKeys {
+		fmt.Fprintf(writer, "strainapi_client_rate_limit_wait_seconds_total{endpoint=%s} %s\n", labelValue(endpoint), formatFloat(p.rateLimitWaits[endpoint].seconds))
+	}
+
+	return writer.Flush()
+}
+
+func writeHeader(writer io.Writer, name string, metricType string, help string) {
+	fmt.Fprintf(writer, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+func writeCounters(writer io.Writer, name string, counters map[string]uint64) {
+	endpoints := make([]string, 0, len(counters))
+	for endpoint := range counters {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+
+	for _, endpoint := range endpoints {
+		fmt.Fprintf(writer, "%s{endpoint=%s} %d\n", name, labelValue(endpoint), counters[endpoint])
+	}
+}
+
+func sortedHistogramKeys(histograms map[string]*histogram) []string {
+	keys := make([]string, 0, len(histograms))
+	for key := range histograms {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// labelValue quotes and escapes a label value.
+func labelValue(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,106 @@
+package strainapiclient
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimiter holds requests back so no more than a fixed number per second
+// are made, allowing bursts of a few at once.  Requests wait their turn in
+// the order they arrive.  It is safe for concurrent use.
+type RateLimiter struct {
+	mutex     sync.Mutex
+	rate      float64
+	burst     float64
+	tokens    float64
+	last      time.Time
+	now       func() time.Time
+	sleep     func(time.Duration)
+	collector MetricsCollector
+}
+
+// NewRateLimiter creates a RateLimiter that allows requestsPerSecond
+// requests a second on average and up to burst at once.  A burst under 1 is
+// 1.  It panics if requestsPerSecond is not a positive, finite number, as
+// no request could ever be let through.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if !(requestsPerSecond > 0) || math.IsInf(requestsPerSecond, 1) {
+		panic(fmt.Sprintf("strainapiclient: NewRateLimiter needs a positive, finite rate, not %v", requestsPerSecond))
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+		now:    time.Now,
+		sleep:  time.Sleep,
+	}
+}
+
+// SetMetricsCollector sets the MetricsCollector every wait is reported to
+// and returns the previous value.
+func (rl *RateLimiter) SetMetricsCollector(collector MetricsCollector) MetricsCollector {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	current := rl.collector
+	rl.collector = collector
+	return current
+}
+
+// reserve takes the turn of a request and returns how long it has to wait
+// for it.
+func (rl *RateLimiter) reserve() (time.Duration, MetricsCollector) {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	now := rl.now()
+	rl.tokens += now.Sub(rl.last).Seconds() * rl.rate
+	if rl.tokens > rl.burst {
+		rl.tokens = rl.burst
+	}
+	rl.last = now
+
+	rl.tokens--
+	if rl.tokens >= 0 {
+		return 0, rl.collector
+	}
+
+	return time.Duration(-rl.tokens / rl.rate * float64(time.Second)), rl.collector
+}
+
+// wait blocks until it is the turn of a request to endpoint and returns how
+// long it waited.
+func (rl *RateLimiter) wait(endpoint string) time.Duration {
+	wait, collector := rl.reserve()
+	if wait <= 0 {
+		return 0
+	}
+
+	rl.sleep(wait)
+	if collector != nil {
+		collector.ObserveRateLimitWait(endpoint, wait)
+	}
+	return wait
+}
+
+// Wrap returns a HandleResourceRequestFunc that waits for its turn before
+// calling next.  It is a Middleware, so add it to a DefaultClient with Use.
+func (rl *RateLimiter) Wrap(next HandleResourceRequestFunc) HandleResourceRequestFunc {
+	return func(resourcePath string) ([]byte, error) {
+		rl.wait(endpointForPath(resourcePathWithoutAPIKey(resourcePath)))
+		return next(resourcePath)
+	}
+}
+
+// RateLimitMiddleware holds requests back to the rate of limiter; see
+// RateLimiter.Wrap.
+func RateLimitMiddleware(limiter *RateLimiter) Middleware {
+	return limiter.Wrap
+}
@@ -0,0 +1,62 @@
+package strainapiclient
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterWrap(t *testing.T) {
+	now := time.Date(2020, 6, 29, 0, 0, 0, 0, time.UTC)
+	waits := make([]time.Duration, 0)
+
+	limiter := NewRateLimiter(2, 2)
+	limiter.last = now
+	limiter.now = func() time.Time { return now }
+	limiter.sleep = func(wait time.Duration) { waits = append(waits, wait) }
+
+	handler := limiter.Wrap(func(resourcePath string) ([]byte, error) {
+		return []byte(resourcePath), nil
+	})
+
+	// The burst goes straight through, then each request waits for the one
+	// before it.
+	for i := 0; i < 4; i++ {
+		if body, err := handler("path"); err != nil || string(body) != "path" {
+			t.Fatalf("Expected the response for path, got %q and %v", body, err)
+		}
+	}
+
+	expected := []time.Duration{500 * time.Millisecond, time.Second}
+	if len(waits) != len(expected) || waits[0] != expected[0] || waits[1] != expected[1] {
+		t.Errorf("Expected waits of %v, got %v", expected, waits)
+	}
+
+	// After a quiet spell the burst is available again, but no more.
+	now = now.Add(time.Minute)
+	waits = waits[:0]
+	for i := 0; i < 3; i++ {
+		_, _ = handler("path")
+	}
+
+	if len(waits) != 1 || waits[0] != 500*time.Millisecond {
+		t.Errorf("Expected only the request after the burst to wait, got %v", waits)
+	}
+}
+
+func TestNewRateLimiterRejectsInvalidRates(t *testing.T) {
+	for _, rate := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected a panic for a rate of %v", rate)
+				}
+			}()
+			NewRateLimiter(rate, 1)
+		}()
+	}
+
+	if limiter := NewRateLimiter(1, 0); limiter.burst != 1 {
+		t.Errorf("Expected a burst of 1, got %v", limiter.burst)
+	}
+}
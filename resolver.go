@@ -0,0 +1,241 @@
+package strainapiclient
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// StrainResolver maps the names and slugs of strains to their IDs and back,
+// so strains can be referred to by name where the API needs an ID.  Names
+// are matched without regard to case, spacing or Unicode form.
+//
+// Slugs come from Slug.  When strains share a slug, the one with the
+// lowest ID gets it and the others get their ID added, as in
+// "blue-dream-42", and then a number from 2 if another strain has that
+// slug already, as in "blue-dream-42-2".  A strain keeps its slug across
+// refreshes while its name still makes the same slug, even if a strain
+// with a lower ID now shares it.  The names and slugs of renamed strains still resolve to them unless
+// another strain has taken them since.
+//
+// It is safe for concurrent use.
+type StrainResolver struct {
+	client Client
+
+	mutex   sync.RWMutex
+	names   map[int]string
+	slugs   map[int]string
+	byName  map[string][]int
+	bySlug  map[string]int
+	aliases map[string]int
+}
+
+// NewStrainResolver creates a StrainResolver from ListAllStrains.  It keeps
+// the strains until Refresh is called.
+func NewStrainResolver(client Client) (*StrainResolver, error) {
+	r := &StrainResolver{client: client, aliases: make(map[string]int)}
+	if err := r.Refresh(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Refresh gets the strains again with ListAllStrains.  The StrainResolver
+// is left as it was if that fails.
+func (r *StrainResolver) Refresh() error {
+	allStrains, err := r.client.ListAllStrains()
+	if err != nil {
+		return err
+	}
+
+	strains := make([]Strain, 0, len(allStrains))
+	for _, strain := range allStrains {
+		strains = append(strains, strain)
+	}
+	sort.Slice(strains, func(i, j int) bool { return strains[i].ID < strains[j].ID })
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	names := make(map[int]string, len(strains))
+	byName := make(map[string][]int, len(strains))
+	baseSlugs := make(map[int]string, len(strains))
+	for _, strain := range strains {
+		normalized, _ := NormalizeStrain(Strain{ID: strain.ID, Name: strain.Name})
+		names[strain.ID] = strain.Name
+		baseSlugs[strain.ID] = normalized.Slug
+
+		key := resolverKey(strain.Name)
+		byName[key] = append(byName[key], strain.ID)
+	}
+
+	slugs := make(map[int]string, len(strains))
+	bySlug := make(map[string]int, len(strains))
+
+	// Strains keep the slug they had if their name still makes it.
+	for _, strain := range strains {
+		previous, found := r.slugs[strain.ID]
+		if found && isSlugOf(previous, baseSlugs[strain.ID], strain.ID) {
+			slugs[strain.ID] = previous
+			bySlug[previous] = strain.ID
+		}
+	}
+
+	// Every other strain whose slug is free gets it before any slug is
+	// suffixed, so a suffix never takes the slug of another strain.
+	for _, strain := range strains {
+		if _, found := slugs[strain.ID]; found {
+			continue
+		}
+		if _, taken := bySlug[baseSlugs[strain.ID]]; !taken {
+			slugs[strain.ID] = baseSlugs[strain.ID]
+			bySlug[baseSlugs[strain.ID]] = strain.ID
+		}
+	}
+
+	for _, strain := range strains {
+		if _, found := slugs[strain.ID]; found {
+			continue
+		}
+
+		suffixed := suffixedSlug(baseSlugs[strain.ID], strain.ID)
+		slug := suffixed
+		for n := 2; ; n++ {
+			if _, taken := bySlug[slug]; !taken {
+				break
+			}
+			slug = suffixedSlug(suffixed, n)
+		}
+		slugs[strain.ID] = slug
+		bySlug[slug] = strain.ID
+	}
+
+	// Remember the names and slugs of strains that were renamed.
+	for id, previousName := range r.names {
+		if _, stillThere := names[id]; !stillThere {
+			continue
+		}
+		if previousName != names[id] {
+			r.aliases[resolverKey(previousName)] = id
+		}
+		if previousSlug := r.slugs[id]; previousSlug != slugs[id] {
+			r.aliases[previousSlug] = id
+		}
+	}
+
+	r.names = names
+	r.slugs = slugs
+	r.byName = byName
+	r.bySlug = bySlug
+	return nil
+}
+
+func resolverKey(name string) string {
+	return strings.ToLower(normalizeText(FieldName, name, func(string, string, string, string) {}))
+}
+
+func suffixedSlug(slug string, n int) string {
+	return fmt.Sprintf("%s-%d", slug, n)
+}
+
+// isSlugOf reports whether slug is one that Refresh could have given the
+// strain with id and baseSlug.
+func isSlugOf(slug string, baseSlug string, id int) bool {
+	suffixed := suffixedSlug(baseSlug, id)
+	return slug == baseSlug || slug == suffixed || strings.HasPrefix(slug, suffixed+"-")
+}
+
+// Resolve returns the ID of the strain with nameOrSlug as its name or slug,
+// or as a name or slug it had before it was renamed.  It returns an error
+// wrapping ErrAmbiguousStrainName for a name that more than one strain has,
+// listing their slugs, and one wrapping ErrStrainNotFound if no strain
+// matches.
+func (r *StrainResolver) Resolve(nameOrSlug string) (int, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	key := resolverKey(nameOrSlug)
+
+	if ids := r.byName[key]; len(ids) == 1 {
+		return ids[0], nil
+	} else if len(ids) > 1 {
+		slugs := make([]string, len(ids))
+		for index, id := range ids {
+			slugs[index] = r.slugs[id]
+		}
+		return 0, fmt.Errorf("%w: %q could be %s", ErrAmbiguousStrainName, nameOrSlug, strings.Join(slugs, ", "))
+	}
+
+	if id, found := r.bySlug[key]; found {
+		return id, nil
+	}
+
+	if id, found := r.aliases[key]; found {
+		if _, stillThere := r.names[id]; stillThere {
+			return id, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: no strain named %q", ErrStrainNotFound, nameOrSlug)
+}
+
+// Name returns the name of the strain with the ID passed in, and whether
+// there is one.
+func (r *StrainResolver) Name(id int) (string, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	name, found := r.names[id]
+	return name, found
+}
+
+// Slug returns the slug of the strain with the ID passed in, and whether
+// there is one.
+func (r *StrainResolver) Slug(id int) (string, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	slug, found := r.slugs[id]
+	return slug, found
+}
+
+// GetStrainDescriptionByStrainName calls GetStrainDescriptionByStrainID
+// with the ID that nameOrSlug resolves to.
+func (r *StrainResolver) GetStrainDescriptionByStrainName(nameOrSlug string) (string, error) {
+	id, err := r.Resolve(nameOrSlug)
+	if err != nil {
+		return "", err
+	}
+	return r.client.GetStrainDescriptionByStrainID(id)
+}
+
+// GetStrainFlavorsByStrainName calls GetStrainFlavorsByStrainID with the ID
+// that nameOrSlug resolves to.
+func (r *StrainResolver) GetStrainFlavorsByStrainName(nameOrSlug string) ([]Flavor, error) {
+	id, err := r.Resolve(nameOrSlug)
+	if err != nil {
+		return make([]Flavor, 0), err
+	}
+	return r.client.GetStrainFlavorsByStrainID(id)
+}
+
+// GetStrainEffectsByStrainName calls GetStrainEffectsByStrainID with the ID
+// that nameOrSlug resolves to.
+func (r *StrainResolver) GetStrainEffectsByStrainName(nameOrSlug string) (EffectsByEffectType, error) {
+	id, err := r.Resolve(nameOrSlug)
+	if err != nil {
+		return make(EffectsByEffectType), err
+	}
+	return r.client.GetStrainEffectsByStrainID(id)
+}
+
+// GetStrainByName calls GetStrainByID with the ID that nameOrSlug resolves
+// to.
+func (r *StrainResolver) GetStrainByName(nameOrSlug string) (Strain, error) {
+	id, err := r.Resolve(nameOrSlug)
+	if err != nil {
+		return Strain{}, err
+	}
+	return GetStrainByID(r.client, id)
+}
@@ -0,0 +1,128 @@
+package strainapiclient_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+func TestStrainResolver(t *testing.T) {
+	resolver, err := strainapiclient.NewStrainResolver(clienttest.NewFixtureClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, nameOrSlug := range []string{"Sour Diesel", "  sour   DIESEL", "sour-diesel"} {
+		if id, err := resolver.Resolve(nameOrSlug); err != nil || id != 5 {
+			t.Errorf("Resolve(%q): expected 5, got %d, %v", nameOrSlug, id, err)
+		}
+	}
+	if name, found := resolver.Name(3); !found || name != "Super Lemon Haze" {
+		t.Errorf("Expected the name of strain 3, got %q, %v", name, found)
+	}
+	if slug, found := resolver.Slug(3); !found || slug != "super-lemon-haze" {
+		t.Errorf("Expected the slug of strain 3, got %q, %v", slug, found)
+	}
+
+	if _, err := resolver.Resolve("Northern Lights"); !errors.Is(err, strainapiclient.ErrStrainNotFound) {
+		t.Errorf("Expected ErrStrainNotFound, got %v", err)
+	}
+	if _, err := resolver.GetStrainFlavorsByStrainName("Northern Lights"); !errors.Is(err, strainapiclient.ErrStrainNotFound) {
+		t.Errorf("Expected ErrStrainNotFound without a request, got %v", err)
+	}
+
+	dataset := clienttest.KnownDataset()
+	if flavors, err := resolver.GetStrainFlavorsByStrainName("afghani"); err != nil || len(flavors) != len(dataset.Strains[1].Flavors) {
+		t.Errorf("Expected the flavors of Afghani, got %v, %v", flavors, err)
+	}
+	if effects, err := resolver.GetStrainEffectsByStrainName("afpak"); err != nil || len(effects[strainapiclient.EffectTypePositive]) == 0 {
+		t.Errorf("Expected the effects of Afpak, got %v, %v", effects, err)
+	}
+	if strain, err := resolver.GetStrainByName("blueberry"); err != nil || strain.ID != 4 || strain.Description != dataset.Strains[3].Description {
+		t.Errorf("Expected Blueberry with its description, got %+v, %v", strain, err)
+	}
+}
+
+func TestStrainResolverCollisionsAndRenames(t *testing.T) {
+	allStrains := `{"Blue Dream":{"id":10,"race":"hybrid"},"blue dream":{"id":11,"race":"sativa"},"Blue-Dream!":{"id":12,"race":"indica"},"Skunk":{"id":20,"race":"hybrid"}}`
+
+	client := strainapiclient.NewDefaultClient(clienttest.FixtureAPIKey)
+	client.SetHandleResourceRequestFunc(func(resourcePath string) ([]byte, error) {
+		return []byte(allStrains), nil
+	})
+
+	resolver, err := strainapiclient.NewStrainResolver(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = resolver.Resolve("Blue Dream")
+	if !errors.Is(err, strainapiclient.ErrAmbiguousStrainName) || !strings.Contains(err.Error(), "blue-dream-11") {
+		t.Errorf("Expected an ambiguous name listing the slugs, got %v", err)
+	}
+	for slug, expected := range map[string]int{"blue-dream": 10, "blue-dream-11": 11, "blue-dream-12": 12, "Blue-Dream!": 12} {
+		if id, err := resolver.Resolve(slug); err != nil || id != expected {
+			t.Errorf("Resolve(%q): expected %d, got %d, %v", slug, expected, id, err)
+		}
+	}
+
+	// 10 and 20 are renamed, 11 is gone, and 9 is new with the name 10 had.
+	allStrains = `{"Blue Dream Classic":{"id":10,"race":"hybrid"},"Blue Dream":{"id":9,"race":"sativa"},"Blue-Dream!":{"id":12,"race":"indica"},"Skunk #1":{"id":20,"race":"hybrid"}}`
+	if err := resolver.Refresh(); err != nil {
+		t.Fatal(err)
+	}
+
+	for nameOrSlug, expected := range map[string]int{
+		"blue-dream-classic": 10,
+		"Blue Dream":         9,
+		"blue-dream":         9,
+		"blue-dream-12":      12,
+		"Skunk":              20,
+		"skunk":              20,
+		"skunk-1":            20,
+	} {
+		if id, err := resolver.Resolve(nameOrSlug); err != nil || id != expected {
+			t.Errorf("After a refresh, Resolve(%q): expected %d, got %d, %v", nameOrSlug, expected, id, err)
+		}
+	}
+	if _, err := resolver.Resolve("blue-dream-11"); !errors.Is(err, strainapiclient.ErrStrainNotFound) {
+		t.Errorf("Expected a strain that is gone not to resolve, got %v", err)
+	}
+}
+
+func TestStrainResolverSuffixedSlugCollisions(t *testing.T) {
+	allStrains := `{"Blue Dream":{"id":3,"race":"hybrid"},"Blue Dream 42":{"id":10,"race":"sativa"},"blue dream":{"id":42,"race":"indica"}}`
+
+	client := strainapiclient.NewDefaultClient(clienttest.FixtureAPIKey)
+	client.SetHandleResourceRequestFunc(func(resourcePath string) ([]byte, error) {
+		return []byte(allStrains), nil
+	})
+
+	resolver, err := strainapiclient.NewStrainResolver(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[int]string{3: "blue-dream", 10: "blue-dream-42", 42: "blue-dream-42-2"}
+	for id, expectedSlug := range expected {
+		if slug, _ := resolver.Slug(id); slug != expectedSlug {
+			t.Errorf("Expected strain %d to have the slug %q, got %q", id, expectedSlug, slug)
+		}
+		if resolved, err := resolver.Resolve(expectedSlug); err != nil || resolved != id {
+			t.Errorf("Resolve(%q): expected %d, got %d, %v", expectedSlug, id, resolved, err)
+		}
+	}
+
+	// The slugs stay the same when the strains are listed again.
+	if err := resolver.Refresh(); err != nil {
+		t.Fatal(err)
+	}
+	for id, expectedSlug := range expected {
+		if slug, _ := resolver.Slug(id); slug != expectedSlug {
+			t.Errorf("Expected strain %d to keep the slug %q, got %q", id, expectedSlug, slug)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package restapi
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+// Index is a local copy of every strain, refreshed from ListAllStrains, so
+// that filtering, sorting and similarity do not need a call to the API.
+// It is safe for concurrent use.
+type Index struct {
+	client strainapiclient.Client
+
+	mutex     sync.RWMutex
+	strains   []strainapiclient.Strain
+	byID      map[int]strainapiclient.Strain
+	refreshed time.Time
+}
+
+// NewIndex creates an empty Index that is filled from client by Refresh.
+func NewIndex(client strainapiclient.Client) *Index {
+	return &Index{client: client, byID: make(map[int]strainapiclient.Strain)}
+}
+
+// Refresh replaces the contents of the index with the current list of strains.
+// The previous contents are kept if the list cannot be retrieved.
+func (i *Index) Refresh() error {
+	allStrains, err := i.client.ListAllStrains()
+	if err != nil {
+		return err
+	}
+
+	strains := make([]strainapiclient.Strain, 0, len(allStrains))
+	byID := make(map[int]strainapiclient.Strain, len(allStrains))
+	for _, strain := range allStrains {
+		strains = append(strains, strain)
+		byID[strain.ID] = strain
+	}
+	sort.Slice(strains, func(a, b int) bool { return strains[a].ID < strains[b].ID })
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.strains = strains
+	i.byID = byID
+	i.refreshed = time.Now()
+
+	return nil
+}
+
+// RefreshEvery refreshes the index every interval until stop is called.
+// Failed refreshes are passed to onError, which may be nil.
+func (i *Index) RefreshEvery(interval time.Duration, onError func(error)) (stop func()) {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				if err := i.Refresh(); err != nil && onError != nil {
+					onError(err)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			ticker.Stop()
+			close(done)
+		})
+	}
+}
+
+// Refreshed returns when the index was last refreshed.
+func (i *Index) Refreshed() time.Time {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	return i.refreshed
+}
+
+// Strain returns the strain with the id passed in.
+func (i *Index) Strain(id int) (strainapiclient.Strain, bool) {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	strain, found := i.byID[id]
+	return strain, found
+}
+
+// Filter selects strains from the index.  Empty fields match everything.
+type Filter struct {
+	// Races matches strains of any of these races
+	Races []strainapiclient.Race
+	// Flavors matches strains with all of these flavors
+	Flavors []strainapiclient.Flavor
+	// Effects matches strains with all of these effects, of any EffectType
+	Effects []string
+	// Query matches strains whose name contains it, ignoring case
+	Query string
+}
+
+// Matches reports whether strain passes the filter.
+func (f Filter) Matches(strain strainapiclient.Strain) bool {
+	if len(f.Races) > 0 {
+		found := false
+		for _, race := range f.Races {
+			if strings.EqualFold(string(race), string(strain.Race)) {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	for _, flavor := range f.Flavors {
+		if !hasFlavor(strain, flavor) {
+			return false
+		}
+	}
+
+	for _, effect := range f.Effects {
+		if !hasEffect(strain, effect) {
+			return false
+		}
+	}
+
+	return f.Query == "" || strings.Contains(strings.ToLower(strain.Name), strings.ToLower(f.Query))
+}
+
+func hasFlavor(strain strainapiclient.Strain, flavor strainapiclient.Flavor) bool {
+	for _, strainFlavor := range strain.Flavors {
+		if strings.EqualFold(string(strainFlavor), string(flavor)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasEffect(strain strainapiclient.Strain, effect string) bool {
+	for _, effectNames := range strain.Effects {
+		for _, effectName := range effectNames {
+			if strings.EqualFold(effectName, effect) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Search returns every strain matching the filter, sorted with
+// strainapiclient.SortResults by keys, and then by ID.
+func (i *Index) Search(filter Filter, keys ...strainapiclient.SortKey) strainapiclient.Strains {
+	i.mutex.RLock()
+	matches := make(strainapiclient.Strains, 0)
+	for _, strain := range i.strains {
+		if filter.Matches(strain) {
+			matches = append(matches, strain)
+		}
+	}
+	i.mutex.RUnlock()
+
+	strainapiclient.SortResults(matches, keys...)
+	return matches
+}
+
+// SimilarStrain is a strain and how similar it is to another, from 0 to 1.
+type SimilarStrain struct {
+	Strain strainapiclient.Strain `json:"strain"`
+	Score  float64                `json:"score"`
+}
+
+// Similar returns up to limit strains most similar to the strain with the id
+// passed in, by the Jaccard similarity of their flavors and effects.
+func (i *Index) Similar(id int, limit int) ([]SimilarStrain, bool) {
+	target, found := i.Strain(id)
+	if !found {
+		return nil, false
+	}
+
+	targetFeatures := features(target)
+
+	i.mutex.RLock()
+	similar := make([]SimilarStrain, 0, len(i.strains))
+	for _, strain := range i.strains {
+		if strain.ID == id {
+			continue
+		}
+
+		score := jaccard(targetFeatures, features(strain))
+		if score > 0 {
+			similar = append(similar, SimilarStrain{Strain: strain, Score: score})
+		}
+	}
+	i.mutex.RUnlock()
+
+	sort.SliceStable(similar, func(a, b int) bool {
+		if similar[a].Score != similar[b].Score {
+			return similar[a].Score > similar[b].Score
+		}
+		return similar[a].Strain.ID < similar[b].Strain.ID
+	})
+
+	if len(similar) > limit {
+		similar = similar[:limit]
+	}
+
+	return similar, true
+}
+
+// features are the flavors and effects of a strain, prefixed so a flavor and
+// an effect with the same name are not confused.
+func features(strain strainapiclient.Strain) map[string]bool {
+	result := make(map[string]bool)
+
+	for _, flavor := range strain.Flavors {
+		result["flavor:"+strings.ToLower(string(flavor))] = true
+	}
+
+	for effectType, effectNames := range strain.Effects {
+		for _, effectName := range effectNames {
+			result[string(effectType)+":"+strings.ToLower(effectName)] = true
+		}
+	}
+
+	return result
+}
+
+func jaccard(a map[string]bool, b map[string]bool) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+
+	intersection := 0
+	for feature := range a {
+		if b[feature] {
+			intersection++
+		}
+	}
+
+	return float64(intersection) / float64(len(a)+len(b)-intersection)
+}
@@ -0,0 +1,203 @@
+package restapi
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+// schemaTypes are the types described in the components of the OpenAPI spec.
+var schemaTypes = []interface{}{
+	strainapiclient.Strain{},
+	StrainPage{},
+	SimilarStrain{},
+	SimilarStrains{},
+	ErrorResponse{},
+}
+
+// enumValues lists the valid values of string types that have them.
+var enumValues = map[reflect.Type][]string{
+	reflect.TypeOf(strainapiclient.Race("")):       raceValues(),
+	reflect.TypeOf(strainapiclient.EffectType("")): effectTypeValues(),
+}
+
+func raceValues() []string {
+	values := make([]string, 0)
+	for _, race := range strainapiclient.AllRaces() {
+		values = append(values, string(race))
+	}
+	return values
+}
+
+func effectTypeValues() []string {
+	values := make([]string, 0)
+	for _, effectType := range strainapiclient.AllEffectTypes() {
+		values = append(values, string(effectType))
+	}
+	return values
+}
+
+// OpenAPISpec returns the OpenAPI 3 description of the REST API.  The
+// schemas are generated from the Go types the endpoints return, so they
+// cannot fall out of date.
+func OpenAPISpec() map[string]interface{} {
+	schemas := make(map[string]interface{})
+	for _, value := range schemaTypes {
+		t := reflect.TypeOf(value)
+		schemas[t.Name()] = structSchema(t)
+	}
+
+	idParameter := map[string]interface{}{
+		"name": "id", "in": "path", "required": true, "schema": map[string]interface{}{"type": "integer"},
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":   "Strain catalog",
+			"version": "1.0.0",
+		},
+		"paths": map[string]interface{}{
+			"/v1/strains": map[string]interface{}{
+				"get": operation("Search strains", "StrainPage", []interface{}{
+					queryParameter("race", "Races to include, comma-separated", enumValues[reflect.TypeOf(strainapiclient.Race(""))]),
+					queryParameter("flavor", "Flavors every strain must have, comma-separated", nil),
+					queryParameter("effect", "Effects every strain must have, comma-separated", nil),
+					queryParameter("q", "Text the strain name must contain", nil),
+					queryParameter("sort", `Fields to sort by, comma-separated, each of id, name or race and descending if it starts with "-", such as "race,-name"`, nil),
+					map[string]interface{}{
+						"name": "limit", "in": "query", "description": "Strains per page",
+						"schema": map[string]interface{}{"type": "integer", "minimum": 1, "maximum": maxLimit, "default": defaultLimit},
+					},
+					queryParameter("cursor", "The next_cursor of the previous page", nil),
+				}),
+			},
+			"/v1/strains/{id}": map[string]interface{}{
+				"get": operation("Get a strain with its description", "Strain", []interface{}{idParameter}),
+			},
+			"/v1/strains/{id}/similar": map[string]interface{}{
+				"get": operation("Get the strains most similar to a strain", "SimilarStrains", []interface{}{
+					idParameter,
+					map[string]interface{}{
+						"name": "limit", "in": "query", "description": "Number of similar strains",
+						"schema": map[string]interface{}{"type": "integer", "minimum": 1, "maximum": maxLimit, "default": defaultSimilarLimit},
+					},
+				}),
+			},
+		},
+		"components": map[string]interface{}{
+			"schemas": schemas,
+		},
+	}
+}
+
+func operation(summary string, responseSchema string, parameters []interface{}) map[string]interface{} {
+	errorResponse := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"description": description,
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{"schema": schemaRef("ErrorResponse")},
+			},
+		}
+	}
+
+	return map[string]interface{}{
+		"summary":    summary,
+		"parameters": parameters,
+		"responses": map[string]interface{}{
+			"200": map[string]interface{}{
+				"description": "OK",
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{"schema": schemaRef(responseSchema)},
+				},
+			},
+			"400": errorResponse("Invalid parameters"),
+			"404": errorResponse("Strain not found"),
+		},
+	}
+}
+
+func queryParameter(name string, description string, enum []string) map[string]interface{} {
+	schema := map[string]interface{}{"type": "string"}
+	if enum != nil {
+		schema["enum"] = enum
+	}
+
+	return map[string]interface{}{"name": name, "in": "query", "description": description, "schema": schema}
+}
+
+func schemaRef(name string) map[string]interface{} {
+	return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+}
+
+// structSchema describes a struct by its JSON fields.
+func structSchema(t reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+	required := make([]string, 0)
+
+	for index := 0; index < t.NumField(); index++ {
+		field := t.Field(index)
+		tagParts := strings.Split(field.Tag.Get("json"), ",")
+		name := tagParts[0]
+		if name == "-" || field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		properties[name] = typeSchema(field.Type)
+
+		omitEmpty := false
+		for _, option := range tagParts[1:] {
+			omitEmpty = omitEmpty || option == "omitempty"
+		}
+		if !omitEmpty {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]interface{}{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	return schema
+}
+
+// typeSchema describes any type, referring to the components for the
+// struct types that have one.
+func typeSchema(t reflect.Type) map[string]interface{} {
+	for _, value := range schemaTypes {
+		if reflect.TypeOf(value) == t {
+			return schemaRef(t.Name())
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		schema := map[string]interface{}{"type": "string"}
+		if enum, found := enumValues[t]; found {
+			schema["enum"] = enum
+		}
+		return schema
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": typeSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": typeSchema(t.Elem())}
+	case reflect.Struct:
+		return structSchema(t)
+	case reflect.Ptr:
+		return typeSchema(t.Elem())
+	}
+
+	return map[string]interface{}{}
+}
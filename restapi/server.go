@@ -0,0 +1,217 @@
+// Package restapi is an HTTP service on top of a strainapiclient.Client with
+// combined endpoints the upstream API does not have:
+//
+//	GET /v1/strains/{id}            a fully hydrated Strain
+//	GET /v1/strains                 strains filtered by race, flavor, effect and name (q),
+//	                                sorted by one or more fields and paged with limit and an
+//	                                opaque cursor
+//	GET /v1/strains/{id}/similar    the strains most similar to a strain
+//	GET /v1/openapi.json            the OpenAPI description of these endpoints
+//
+// Lists are served from an Index that is refreshed from ListAllStrains.
+package restapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+const (
+	defaultLimit        = 50
+	maxLimit            = 500
+	defaultSimilarLimit = 10
+)
+
+// StrainPage is one page of the results of a strain search.
+type StrainPage struct {
+	Strains    []strainapiclient.Strain `json:"strains"`
+	Total      int                      `json:"total"`
+	NextCursor string                   `json:"next_cursor,omitempty"`
+}
+
+// SimilarStrains is the response for the similar strains of a strain.
+type SimilarStrains struct {
+	Strain  strainapiclient.Strain `json:"strain"`
+	Similar []SimilarStrain        `json:"similar"`
+}
+
+// ErrorResponse is the body of every response that is not successful.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Server serves the REST API.  Descriptions, which are not part of the list
+// of all strains, are requested from the Client when a strain is retrieved.
+type Server struct {
+	client strainapiclient.Client
+	index  *Index
+}
+
+// NewServer creates a Server that serves strains from index and
+// descriptions from client.
+func NewServer(client strainapiclient.Client, index *Index) *Server {
+	return &Server{client: client, index: index}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	switch {
+	case len(segments) == 2 && segments[0] == "v1" && segments[1] == "openapi.json":
+		writeJSON(w, http.StatusOK, OpenAPISpec())
+	case len(segments) == 2 && segments[0] == "v1" && segments[1] == "strains":
+		s.searchStrains(w, r)
+	case len(segments) == 3 && segments[0] == "v1" && segments[1] == "strains":
+		s.getStrain(w, segments[2])
+	case len(segments) == 4 && segments[0] == "v1" && segments[1] == "strains" && segments[3] == "similar":
+		s.similarStrains(w, r, segments[2])
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *Server) getStrain(w http.ResponseWriter, idSegment string) {
+	strain, ok := s.indexedStrain(w, idSegment)
+	if !ok {
+		return
+	}
+
+	description, err := s.client.GetStrainDescriptionByStrainID(strain.ID)
+	if err != nil && !errors.Is(err, strainapiclient.ErrDescriptionNotFound) {
+		writeError(w, http.StatusBadGateway, "Problem getting the description of the strain")
+		return
+	}
+	strain.Description = description
+
+	writeJSON(w, http.StatusOK, strain)
+}
+
+func (s *Server) searchStrains(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	filter := Filter{Query: query.Get("q")}
+	for _, value := range listParameter(query["race"]) {
+		race, err := strainapiclient.ParseRace(value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		filter.Races = append(filter.Races, race)
+	}
+	for _, flavor := range listParameter(query["flavor"]) {
+		filter.Flavors = append(filter.Flavors, strainapiclient.Flavor(flavor))
+	}
+	filter.Effects = listParameter(query["effect"])
+
+	sortKeys, err := strainapiclient.ParseSortKeys(query.Get("sort"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limit, err := limitParameter(query.Get("limit"), defaultLimit)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	matches := s.index.Search(filter, sortKeys...)
+
+	resultsPage, err := strainapiclient.Paginate(matches, strainapiclient.PageRequest{Limit: limit, Cursor: query.Get("cursor")})
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page := StrainPage{
+		Strains:    matches[resultsPage.Start:resultsPage.End],
+		Total:      resultsPage.Total,
+		NextCursor: resultsPage.NextCursor,
+	}
+
+	writeJSON(w, http.StatusOK, page)
+}
+
+func (s *Server) similarStrains(w http.ResponseWriter, r *http.Request, idSegment string) {
+	strain, ok := s.indexedStrain(w, idSegment)
+	if !ok {
+		return
+	}
+
+	limit, err := limitParameter(r.URL.Query().Get("limit"), defaultSimilarLimit)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	similar, _ := s.index.Similar(strain.ID, limit)
+	writeJSON(w, http.StatusOK, SimilarStrains{Strain: strain, Similar: similar})
+}
+
+// indexedStrain finds the strain for an ID from the URL, writing the error
+// response if there is none.
+func (s *Server) indexedStrain(w http.ResponseWriter, idSegment string) (strainapiclient.Strain, bool) {
+	id, err := strconv.Atoi(idSegment)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid strain ID %q", idSegment))
+		return strainapiclient.Strain{}, false
+	}
+
+	strain, found := s.index.Strain(id)
+	if !found {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("No strain with ID %d", id))
+		return strainapiclient.Strain{}, false
+	}
+
+	return strain, true
+}
+
+// listParameter accepts both repeated and comma-separated query parameters.
+func listParameter(values []string) []string {
+	result := make([]string, 0)
+
+	for _, value := range values {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				result = append(result, item)
+			}
+		}
+	}
+
+	return result
+}
+
+func limitParameter(value string, defaultValue int) (int, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 || limit > maxLimit {
+		return 0, fmt.Errorf("limit must be a number from 1 to %d", maxLimit)
+	}
+
+	return limit, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, ErrorResponse{Error: message})
+}
@@ -0,0 +1,184 @@
+package restapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+func newTestServer(t *testing.T) *Server {
+	client := clienttest.NewFixtureClient()
+	index := NewIndex(client)
+	if err := index.Refresh(); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewServer(client, index)
+}
+
+func get(t *testing.T, server *Server, path string, expectedStatus int, response interface{}) {
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+
+	if recorder.Code != expectedStatus {
+		t.Fatalf("GET %s: expected status %d, got %d: %s", path, expectedStatus, recorder.Code, recorder.Body.String())
+	}
+
+	if err := json.Unmarshal(recorder.Body.Bytes(), response); err != nil {
+		t.Fatalf("GET %s: expected JSON, got %s", path, recorder.Body.String())
+	}
+}
+
+func strainNames(page StrainPage) []string {
+	names := make([]string, len(page.Strains))
+	for index, strain := range page.Strains {
+		names[index] = strain.Name
+	}
+	return names
+}
+
+func TestGetStrain(t *testing.T) {
+	server := newTestServer(t)
+	expected, _ := clienttest.KnownDataset().StrainByID(4)
+
+	var response struct {
+		Name        string              `json:"name"`
+		Description string              `json:"desc"`
+		Flavors     []string            `json:"flavors"`
+		Effects     map[string][]string `json:"effects"`
+	}
+	get(t, server, "/v1/strains/4", http.StatusOK, &response)
+
+	if response.Name != expected.Name || response.Description != expected.Description ||
+		len(response.Flavors) != 2 || len(response.Effects["positive"]) != 4 {
+		t.Errorf("Expected the fully hydrated %s, got %+v", expected.Name, response)
+	}
+
+	var errorResponse ErrorResponse
+	get(t, server, "/v1/strains/999999", http.StatusNotFound, &errorResponse)
+	get(t, server, "/v1/strains/abc", http.StatusBadRequest, &errorResponse)
+}
+
+func TestSearchStrains(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		{"", []string{"Afpak", "Afghani", "Super Lemon Haze", "Blueberry", "Sour Diesel"}},
+		{"?race=Sativa,hybrid&sort=-name", []string{"Super Lemon Haze", "Sour Diesel", "Afpak"}},
+		{"?flavor=earthy&flavor=pine&sort=name", []string{"Afghani", "Afpak"}},
+		{"?effect=Happy,Creative&race=sativa", []string{"Super Lemon Haze", "Sour Diesel"}},
+		{"?q=af&effect=dizzy&sort=-id", []string{"Afghani", "Afpak"}},
+		{"?sort=race,-name", []string{"Afpak", "Blueberry", "Afghani", "Super Lemon Haze", "Sour Diesel"}},
+		{"?q=no%20such%20strain", []string{}},
+	}
+
+	for _, test := range tests {
+		var page StrainPage
+		get(t, server, "/v1/strains"+test.query, http.StatusOK, &page)
+
+		names := strainNames(page)
+		if len(names) != len(test.expected) || page.Total != len(test.expected) {
+			t.Errorf("GET /v1/strains%s: expected %v, got %v (total %d)", test.query, test.expected, names, page.Total)
+			continue
+		}
+		for index := range names {
+			if names[index] != test.expected[index] {
+				t.Errorf("GET /v1/strains%s: expected %v, got %v", test.query, test.expected, names)
+				break
+			}
+		}
+	}
+
+	var errorResponse ErrorResponse
+	get(t, server, "/v1/strains?sort=flavor", http.StatusBadRequest, &errorResponse)
+	get(t, server, "/v1/strains?limit=0", http.StatusBadRequest, &errorResponse)
+	get(t, server, "/v1/strains?cursor=not-a-cursor", http.StatusBadRequest, &errorResponse)
+	get(t, server, "/v1/strains?race=ruderalis", http.StatusBadRequest, &errorResponse)
+}
+
+func TestSearchStrainsPaging(t *testing.T) {
+	server := newTestServer(t)
+
+	names := make([]string, 0)
+	path := "/v1/strains?sort=name&limit=2"
+	for pages := 0; pages < 10; pages++ {
+		var page StrainPage
+		get(t, server, path, http.StatusOK, &page)
+
+		if len(page.Strains) > 2 || page.Total != 5 {
+			t.Fatalf("Expected pages of at most 2 of 5 strains, got %d of %d", len(page.Strains), page.Total)
+		}
+		names = append(names, strainNames(page)...)
+
+		if page.NextCursor == "" {
+			break
+		}
+		path = "/v1/strains?sort=name&limit=2&cursor=" + page.NextCursor
+	}
+
+	expected := []string{"Afghani", "Afpak", "Blueberry", "Sour Diesel", "Super Lemon Haze"}
+	if len(names) != len(expected) {
+		t.Fatalf("Expected to page through %v, got %v", expected, names)
+	}
+	for index := range names {
+		if names[index] != expected[index] {
+			t.Errorf("Expected to page through %v, got %v", expected, names)
+			break
+		}
+	}
+}
+
+func TestSimilarStrains(t *testing.T) {
+	server := newTestServer(t)
+
+	var response SimilarStrains
+	get(t, server, "/v1/strains/1/similar?limit=2", http.StatusOK, &response)
+
+	if response.Strain.ID != 1 || len(response.Similar) != 2 {
+		t.Fatalf("Expected two strains similar to Afpak, got %+v", response)
+	}
+
+	if response.Similar[0].Strain.Name != "Afghani" || response.Similar[0].Score < response.Similar[1].Score {
+		t.Errorf("Expected Afghani to be the most similar to Afpak, got %+v", response.Similar)
+	}
+}
+
+func TestOpenAPISpec(t *testing.T) {
+	server := newTestServer(t)
+
+	var spec struct {
+		OpenAPI    string                 `json:"openapi"`
+		Paths      map[string]interface{} `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]map[string]interface{} `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	get(t, server, "/v1/openapi.json", http.StatusOK, &spec)
+
+	if spec.OpenAPI == "" || len(spec.Paths) != 3 {
+		t.Errorf("Expected an OpenAPI document with three paths, got %+v", spec)
+	}
+
+	strainSchema := spec.Components.Schemas["Strain"]
+	for _, field := range []string{"name", "id", "desc", "race", "flavors", "effects"} {
+		if _, found := strainSchema.Properties[field]; !found {
+			t.Errorf("Expected the Strain schema to have the %q property generated from the Go type", field)
+		}
+	}
+
+	if strainSchema.Properties["race"]["enum"] == nil {
+		t.Error("Expected the race property to list the valid races")
+	}
+
+	if spec.Components.Schemas["StrainPage"].Properties["strains"]["items"].(map[string]interface{})["$ref"] != "#/components/schemas/Strain" {
+		t.Errorf("Expected StrainPage.strains to refer to the Strain schema, got %v", spec.Components.Schemas["StrainPage"])
+	}
+}
@@ -0,0 +1,439 @@
+package strainapiclient
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrUnknownSortField is returned for a sort field that is not one of the
+// SortBy constants.
+var ErrUnknownSortField = errors.New("Unknown sort field")
+
+// ErrInvalidCursor is returned for a cursor that was not made by Paginate.
+var ErrInvalidCursor = errors.New("Invalid cursor")
+
+// ErrUnknownField is returned by Project for a field the results do not
+// have.
+var ErrUnknownField = errors.New("Unknown field")
+
+// Results is a set of strains that can be sorted with SortResults, paged
+// with Paginate and projected with Project.  Every search result type
+// implements it, and so does Strains, which ListAllStrainsResult.Strains
+// returns.
+type Results interface {
+	Len() int
+	Swap(i int, j int)
+
+	// ID, Name and Race return the fields of the result at index that
+	// results are sorted by.
+	ID(index int) int
+	Name(index int) string
+	Race(index int) Race
+
+	// Value returns the result at index itself.
+	Value(index int) interface{}
+}
+
+// Strains is a slice of Strain that implements Results.
+type Strains []Strain
+
+// Strains returns the strains in r as Strains, in order of ID, since a map
+// has no order of its own.
+func (r ListAllStrainsResult) Strains() Strains {
+	strains := make(Strains, 0, len(r))
+	for _, strain := range r {
+		strains = append(strains, strain)
+	}
+
+	sort.Slice(strains, func(i, j int) bool { return strains[i].ID < strains[j].ID })
+	return strains
+}
+
+// Len implements Results.
+func (s Strains) Len() int { return len(s) }
+
+// Swap implements Results.
+func (s Strains) Swap(i int, j int) { s[i], s[j] = s[j], s[i] }
+
+// ID implements Results.
+func (s Strains) ID(index int) int { return s[index].ID }
+
+// Name implements Results.
+func (s Strains) Name(index int) string { return s[index].Name }
+
+// Race implements Results.
+func (s Strains) Race(index int) Race { return s[index].Race }
+
+// Value implements Results.
+func (s Strains) Value(index int) interface{} { return s[index] }
+
+// Len implements Results.
+func (r SearchStrainsByNameResults) Len() int { return len(r) }
+
+// Swap implements Results.
+func (r SearchStrainsByNameResults) Swap(i int, j int) { r[i], r[j] = r[j], r[i] }
+
+// ID implements Results.
+func (r SearchStrainsByNameResults) ID(index int) int { return r[index].ID }
+
+// Name implements Results.
+func (r SearchStrainsByNameResults) Name(index int) string { return r[index].Name }
+
+// Race implements Results.
+func (r SearchStrainsByNameResults) Race(index int) Race { return r[index].Race }
+
+// Value implements Results.
+func (r SearchStrainsByNameResults) Value(index int) interface{} { return r[index] }
+
+// Len implements Results.
+func (r SearchStrainsByRaceResults) Len() int { return len(r) }
+
+// Swap implements Results.
+func (r SearchStrainsByRaceResults) Swap(i int, j int) { r[i], r[j] = r[j], r[i] }
+
+// ID implements Results.
+func (r SearchStrainsByRaceResults) ID(index int) int { return r[index].ID }
+
+// Name implements Results.
+func (r SearchStrainsByRaceResults) Name(index int) string { return r[index].Name }
+
+// Race implements Results.
+func (r SearchStrainsByRaceResults) Race(index int) Race { return r[index].Race }
+
+// Value implements Results.
+func (r SearchStrainsByRaceResults) Value(index int) interface{} { return r[index] }
+
+// Len implements Results.
+func (r SearchStrainsByEffectNameResults) Len() int { return len(r) }
+
+// Swap implements Results.
+func (r SearchStrainsByEffectNameResults) Swap(i int, j int) { r[i], r[j] = r[j], r[i] }
+
+// ID implements Results.
+func (r SearchStrainsByEffectNameResults) ID(index int) int { return r[index].ID }
+
+// Name implements Results.
+func (r SearchStrainsByEffectNameResults) Name(index int) string { return r[index].Name }
+
+// Race implements Results.
+func (r SearchStrainsByEffectNameResults) Race(index int) Race { return r[index].Race }
+
+// Value implements Results.
+func (r SearchStrainsByEffectNameResults) Value(index int) interface{} { return r[index] }
+
+// Len implements Results.
+func (r SearchStrainsByFlavorResults) Len() int { return len(r) }
+
+// Swap implements Results.
+func (r SearchStrainsByFlavorResults) Swap(i int, j int) { r[i], r[j] = r[j], r[i] }
+
+// ID implements Results.
+func (r SearchStrainsByFlavorResults) ID(index int) int { return r[index].ID }
+
+// Name implements Results.
+func (r SearchStrainsByFlavorResults) Name(index int) string { return r[index].Name }
+
+// Race implements Results.
+func (r SearchStrainsByFlavorResults) Race(index int) Race { return r[index].Race }
+
+// Value implements Results.
+func (r SearchStrainsByFlavorResults) Value(index int) interface{} { return r[index] }
+
+// SortField is a field results can be sorted by.
+type SortField string
+
+// The fields results can be sorted by.
+const (
+	SortByID   SortField = "id"
+	SortByName SortField = "name"
+	SortByRace SortField = "race"
+)
+
+// SortKey is a field to sort by and its direction.
+type SortKey struct {
+	Field      SortField
+	Descending bool
+}
+
+func (k SortKey) String() string {
+	if k.Descending {
+		return "-" + string(k.Field)
+	}
+	return string(k.Field)
+}
+
+// ParseSortKeys parses a list of fields separated by commas, each
+// descending if it starts with "-", such as "race,-name".  It returns an
+// error wrapping ErrUnknownSortField for any other field.
+func ParseSortKeys(value string) ([]SortKey, error) {
+	keys := make([]SortKey, 0)
+
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		key := SortKey{Field: SortField(strings.TrimPrefix(field, "-")), Descending: strings.HasPrefix(field, "-")}
+		switch key.Field {
+		case SortByID, SortByName, SortByRace:
+		default:
+			return nil, fmt.Errorf("%w: %q", ErrUnknownSortField, field)
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// resultsSorter sorts Results by its keys and then by ID, so the order is
+// the same every time whatever order the results were in.
+type resultsSorter struct {
+	Results
+	keys []SortKey
+}
+
+func (s resultsSorter) Less(i int, j int) bool {
+	for _, key := range s.keys {
+		if comparison := compareResults(s.Results, key.Field, i, j); comparison != 0 {
+			if key.Descending {
+				return comparison > 0
+			}
+			return comparison < 0
+		}
+	}
+
+	return s.ID(i) < s.ID(j)
+}
+
+// compareResults returns -1, 0 or 1 as field of the result at i is before,
+// the same as or after that of the result at j.  Names are compared
+// without regard to case first.
+func compareResults(results Results, field SortField, i int, j int) int {
+	switch field {
+	case SortByID:
+		return compareInts(results.ID(i), results.ID(j))
+	case SortByName:
+		if comparison := strings.Compare(strings.ToLower(results.Name(i)), strings.ToLower(results.Name(j))); comparison != 0 {
+			return comparison
+		}
+		return strings.Compare(results.Name(i), results.Name(j))
+	case SortByRace:
+		return strings.Compare(string(results.Race(i)), string(results.Race(j)))
+	}
+	return 0
+}
+
+func compareInts(a int, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// SortResults sorts results in place by keys, and then by ID.  With no
+// keys, it sorts by ID.
+//
+//	results, err := client.SearchStrainsByRace(RaceSativa)
+//	...
+//	SortResults(results, SortKey{Field: SortByName})
+func SortResults(results Results, keys ...SortKey) {
+	sort.Sort(resultsSorter{Results: results, keys: keys})
+}
+
+// DefaultPageLimit is the number of results on a page when
+// PageRequest.Limit is 0.
+const DefaultPageLimit = 50
+
+// PageRequest is the page of results to return: Limit results from Offset,
+// or from after the last result of the previous page if Cursor is set.
+type PageRequest struct {
+	Offset int
+	Limit  int
+	Cursor string
+}
+
+// Page is where a page is in the results, so it can be sliced out of them
+// with results[page.Start:page.End].  NextCursor is empty on the last page.
+type Page struct {
+	Start      int    `json:"start"`
+	End        int    `json:"end"`
+	Total      int    `json:"total"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// Paginate returns the Page of results for request.  Results should be
+// sorted the same way for every page, with SortResults.
+//
+// A cursor holds the ID of the last result on the page before it and its
+// position.  The next page starts after that result wherever it is now,
+// so a page is not skipped or repeated when results before it were added
+// or removed in between; if the result is gone, the page starts at the
+// position instead.  It returns an error wrapping ErrInvalidCursor for a
+// cursor it did not make.
+func Paginate(results Results, request PageRequest) (Page, error) {
+	limit := request.Limit
+	if limit <= 0 {
+		limit = DefaultPageLimit
+	}
+
+	start := request.Offset
+	if request.Cursor != "" {
+		id, offset, err := decodeResultsCursor(request.Cursor)
+		if err != nil {
+			return Page{}, err
+		}
+
+		start = offset
+		for index := 0; index < results.Len(); index++ {
+			if results.ID(index) == id {
+				start = index + 1
+				break
+			}
+		}
+	}
+
+	total := results.Len()
+	if start < 0 {
+		start = 0
+	}
+	if start > total {
+		start = total
+	}
+	end := start + limit
+	if end > total {
+		end = total
+	}
+
+	page := Page{Start: start, End: end, Total: total}
+	if end < total && end > 0 {
+		page.NextCursor = encodeResultsCursor(results.ID(end-1), end)
+	}
+	return page, nil
+}
+
+const resultsCursorPrefix string = "after:"
+
+func encodeResultsCursor(id int, offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(resultsCursorPrefix + strconv.Itoa(id) + ":" + strconv.Itoa(offset)))
+}
+
+func decodeResultsCursor(cursor string) (int, int, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err == nil && strings.HasPrefix(string(decoded), resultsCursorPrefix) {
+		parts := strings.Split(strings.TrimPrefix(string(decoded), resultsCursorPrefix), ":")
+		if len(parts) == 2 {
+			id, idErr := strconv.Atoi(parts[0])
+			offset, offsetErr := strconv.Atoi(parts[1])
+			if idErr == nil && offsetErr == nil && offset >= 0 {
+				return id, offset, nil
+			}
+		}
+	}
+
+	return 0, 0, fmt.Errorf("%w: %q", ErrInvalidCursor, cursor)
+}
+
+// Project returns each result as a map of only the fields passed in, named
+// as in its JSON, such as "id" and "name", or of all of its fields if none
+// are passed in.  Numbers are json.Number, so IDs stay exact.  It returns an
+// error wrapping ErrUnknownField for a field the type of the results does
+// not have, even when there are no results.
+func Project(results Results, fields ...string) ([]map[string]interface{}, error) {
+	known, knownOK := resultFields(results)
+	if knownOK {
+		for _, field := range fields {
+			if !known[field] {
+				return nil, fmt.Errorf("%w: %q", ErrUnknownField, field)
+			}
+		}
+	}
+
+	projected := make([]map[string]interface{}, results.Len())
+
+	for index := range projected {
+		data, err := json.Marshal(results.Value(index))
+		if err != nil {
+			return nil, err
+		}
+
+		var all map[string]interface{}
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		if err := decoder.Decode(&all); err != nil {
+			return nil, err
+		}
+
+		if len(fields) == 0 {
+			projected[index] = all
+			continue
+		}
+
+		projected[index] = make(map[string]interface{}, len(fields))
+		for _, field := range fields {
+			if _, found := all[field]; !found && !knownOK {
+				return nil, fmt.Errorf("%w: %q", ErrUnknownField, field)
+			}
+			// A known field left out with omitempty is null.
+			projected[index][field] = all[field]
+		}
+	}
+
+	return projected, nil
+}
+
+// resultFields returns the JSON names of the fields of the results, from
+// the element type of a slice or else from the first result, and whether
+// they could be found.
+func resultFields(results Results) (map[string]bool, bool) {
+	t := reflect.TypeOf(results)
+	if t.Kind() == reflect.Slice {
+		t = t.Elem()
+	} else if results.Len() > 0 {
+		t = reflect.TypeOf(results.Value(0))
+	} else {
+		return nil, false
+	}
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	fields := make(map[string]bool)
+	addJSONFieldNames(fields, t)
+	return fields, true
+}
+
+// addJSONFieldNames adds the JSON names of the fields of the struct type t
+// to fields, including those of embedded structs.
+func addJSONFieldNames(fields map[string]bool, t reflect.Type) {
+	for index := 0; index < t.NumField(); index++ {
+		field := t.Field(index)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			addJSONFieldNames(fields, field.Type)
+			continue
+		}
+		if name == "-" || field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = true
+	}
+}
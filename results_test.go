@@ -0,0 +1,124 @@
+package strainapiclient_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+func resultIDs(results strainapiclient.Results) []int {
+	ids := make([]int, results.Len())
+	for index := range ids {
+		ids[index] = results.ID(index)
+	}
+	return ids
+}
+
+func TestSortResults(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+
+	allStrains, err := client.ListAllStrains()
+	if err != nil {
+		t.Fatal(err)
+	}
+	strains := allStrains.Strains()
+	if ids := resultIDs(strains); !reflect.DeepEqual(ids, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("Expected all strains in order of ID, got %v", ids)
+	}
+
+	keys, err := strainapiclient.ParseSortKeys("race, -name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	strainapiclient.SortResults(strains, keys...)
+	if ids := resultIDs(strains); !reflect.DeepEqual(ids, []int{1, 4, 2, 3, 5}) {
+		t.Errorf("Expected strains by race and then name descending, got %v", ids)
+	}
+
+	effectResults, err := client.SearchStrainsByEffectName("Happy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	strainapiclient.SortResults(effectResults, strainapiclient.SortKey{Field: strainapiclient.SortByName})
+	if names := []string{effectResults[0].Name, effectResults[4].Name}; !reflect.DeepEqual(names, []string{"Afghani", "Super Lemon Haze"}) {
+		t.Errorf("Expected the search results by name, got %v", effectResults)
+	}
+
+	if _, err := strainapiclient.ParseSortKeys("id,potency"); !errors.Is(err, strainapiclient.ErrUnknownSortField) {
+		t.Errorf("Expected ErrUnknownSortField, got %v", err)
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	allStrains, err := clienttest.NewFixtureClient().ListAllStrains()
+	if err != nil {
+		t.Fatal(err)
+	}
+	strains := allStrains.Strains()
+
+	page, err := strainapiclient.Paginate(strains, strainapiclient.PageRequest{Offset: 1, Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := resultIDs(strains[page.Start:page.End]); !reflect.DeepEqual(ids, []int{2, 3}) || page.Total != 5 || page.NextCursor == "" {
+		t.Errorf("Expected the second and third strains with a cursor, got %v %+v", ids, page)
+	}
+
+	// The strain before the cursor is removed between pages.
+	strains = append(strains[:0:0], strains[0], strains[2], strains[3], strains[4])
+	next, err := strainapiclient.Paginate(strains, strainapiclient.PageRequest{Limit: 2, Cursor: page.NextCursor})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := resultIDs(strains[next.Start:next.End]); !reflect.DeepEqual(ids, []int{4, 5}) || next.NextCursor != "" {
+		t.Errorf("Expected the page after strain 3 and no more pages, got %v %+v", ids, next)
+	}
+
+	if beyond, err := strainapiclient.Paginate(strains, strainapiclient.PageRequest{Offset: 10}); err != nil || beyond.Start != beyond.End {
+		t.Errorf("Expected an empty page past the end, got %+v, %v", beyond, err)
+	}
+	if _, err := strainapiclient.Paginate(strains, strainapiclient.PageRequest{Cursor: "not-a-cursor"}); !errors.Is(err, strainapiclient.ErrInvalidCursor) {
+		t.Errorf("Expected ErrInvalidCursor, got %v", err)
+	}
+}
+
+func TestProject(t *testing.T) {
+	results, err := clienttest.NewFixtureClient().SearchStrainsByFlavor("Earthy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	strainapiclient.SortResults(results)
+
+	projected, err := strainapiclient.Project(results, "id", "name")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(projected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `[{"id":1,"name":"Afpak"},{"id":2,"name":"Afghani"},{"id":5,"name":"Sour Diesel"}]`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	if all, err := strainapiclient.Project(results); err != nil || all[0]["flavor"] != "Earthy" {
+		t.Errorf("Expected every field without any fields passed in, got %v, %v", all, err)
+	}
+	if _, err := strainapiclient.Project(results, "desc"); !errors.Is(err, strainapiclient.ErrUnknownField) {
+		t.Errorf("Expected ErrUnknownField, got %v", err)
+	}
+
+	empty := strainapiclient.SearchStrainsByFlavorResults{}
+	if _, err := strainapiclient.Project(empty, "desc"); !errors.Is(err, strainapiclient.ErrUnknownField) {
+		t.Errorf("Expected ErrUnknownField without any results, got %v", err)
+	}
+	if projected, err := strainapiclient.Project(empty, "id", "flavor"); err != nil || len(projected) != 0 {
+		t.Errorf("Expected no results for known fields, got %v, %v", projected, err)
+	}
+}
@@ -0,0 +1,490 @@
+package strainapiclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Names of the endpoints whose JSON shapes the client relies on.  These are
+// the same as the names of the Client methods that call them.
+const (
+	endpointListAllEffects                 = "ListAllEffects"
+	endpointListAllFlavors                 = "ListAllFlavors"
+	endpointListAllStrains                 = "ListAllStrains"
+	endpointSearchStrainsByName            = "SearchStrainsByName"
+	endpointSearchStrainsByRace            = "SearchStrainsByRace"
+	endpointSearchStrainsByFlavor          = "SearchStrainsByFlavor"
+	endpointSearchStrainsByEffectName      = "SearchStrainsByEffectName"
+	endpointGetStrainDescriptionByStrainID = "GetStrainDescriptionByStrainID"
+	endpointGetStrainFlavorsByStrainID     = "GetStrainFlavorsByStrainID"
+	endpointGetStrainEffectsByStrainID     = "GetStrainEffectsByStrainID"
+)
+
+// strainDescriptionResult is the shape of the JSON returned for a description.
+type strainDescriptionResult struct {
+	Description string `json:"desc"`
+}
+
+// endpointSchema describes the JSON shape the client expects from an endpoint.
+type endpointSchema struct {
+	fields map[string]string
+	// optional fields are filled in by the client rather than the API
+	optional map[string]bool
+	// nullable fields are null when the API has no value for them, as
+	// descriptions are
+	nullable map[string]bool
+}
+
+// endpointSchemas are built from the same types the client unmarshals into.
+// Types with custom unmarshalling (EffectsByEffectType) are described by
+// the shape of the JSON they accept.
+var endpointSchemas = map[string]endpointSchema{
+	endpointListAllEffects:                 newEndpointSchema([]Effect{}),
+	endpointListAllFlavors:                 newEndpointSchema([]Flavor{}),
+	endpointListAllStrains:                 newEndpointSchema(ListAllStrainsResult{}, "{*}.name", "{*}.desc").withNullable("{*}.desc"),
+	endpointSearchStrainsByName:            newEndpointSchema(SearchStrainsByNameResults{}).withNullable("[].desc"),
+	endpointSearchStrainsByRace:            newEndpointSchema(SearchStrainsByRaceResults{}),
+	endpointSearchStrainsByFlavor:          newEndpointSchema(SearchStrainsByFlavorResults{}),
+	endpointSearchStrainsByEffectName:      newEndpointSchema(SearchStrainsByEffectNameResults{}),
+	endpointGetStrainDescriptionByStrainID: newEndpointSchema(strainDescriptionResult{}).withNullable(".desc"),
+	endpointGetStrainFlavorsByStrainID:     newEndpointSchema([]Flavor{}),
+	endpointGetStrainEffectsByStrainID:     newEndpointSchema(map[EffectType][]string{}),
+}
+
+func newEndpointSchema(value interface{}, optionalFields ...string) endpointSchema {
+	schema := endpointSchema{fields: make(map[string]string), optional: make(map[string]bool)}
+	addTypeToSchema(schema.fields, "", reflect.TypeOf(value))
+
+	for _, field := range optionalFields {
+		schema.optional[field] = true
+	}
+
+	return schema
+}
+
+// withNullable returns the schema with fields that may be null.
+func (s endpointSchema) withNullable(fields ...string) endpointSchema {
+	s.nullable = make(map[string]bool, len(fields))
+	for _, field := range fields {
+		s.nullable[field] = true
+	}
+	return s
+}
+
+// addTypeToSchema records the JSON type of t at path and recurses into
+// its elements.  Array elements are recorded at path + "[]" and the values of
+// maps (objects with arbitrary keys) at path + "{*}".
+func addTypeToSchema(fields map[string]string, path string, t reflect.Type) {
+	switch t.Kind() {
+	case reflect.String:
+		fields[path] = "string"
+	case reflect.Bool:
+		fields[path] = "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		fields[path] = "number"
+	case reflect.Slice, reflect.Array:
+		fields[path] = "array"
+		addTypeToSchema(fields, path+"[]", t.Elem())
+	case reflect.Map:
+		fields[path] = "object"
+		addTypeToSchema(fields, path+"{*}", t.Elem())
+	case reflect.Struct:
+		fields[path] = "object"
+		for index := 0; index < t.NumField(); index++ {
+			field := t.Field(index)
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if name == "-" || field.PkgPath != "" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			addTypeToSchema(fields, path+"."+name, field.Type)
+		}
+	case reflect.Ptr:
+		addTypeToSchema(fields, path, t.Elem())
+	}
+}
+
+// SchemaField is a single field of a JSON response that differs from what
+// the client expects.  Path uses "[]" for array elements and "{*}" for the
+// values of objects keyed by data (such as strain names).
+type SchemaField struct {
+	Path     string
+	Expected string
+	Actual   string
+}
+
+// EndpointSchemaReport is the result of comparing one endpoint's response
+// with the shape the client expects.
+type EndpointSchemaReport struct {
+	Endpoint     string
+	ResourcePath string
+	Added        []SchemaField
+	Removed      []SchemaField
+	Changed      []SchemaField
+	Err          error
+}
+
+// HasDrift is true if the endpoint could not be checked or its
+// response differs from the expected shape.
+func (r EndpointSchemaReport) HasDrift() bool {
+	return r.Err != nil || len(r.Added) > 0 || len(r.Removed) > 0 || len(r.Changed) > 0
+}
+
+// SchemaReport is the result of a CheckSchema call.
+type SchemaReport []EndpointSchemaReport
+
+// HasDrift is true if any endpoint has drifted.
+func (r SchemaReport) HasDrift() bool {
+	for _, endpointReport := range r {
+		if endpointReport.HasDrift() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String summarizes the drift for each endpoint, one line per difference.
+func (r SchemaReport) String() string {
+	var builder strings.Builder
+
+	for _, endpointReport := range r {
+		if !endpointReport.HasDrift() {
+			fmt.Fprintf(&builder, "%s: OK\n", endpointReport.Endpoint)
+			continue
+		}
+
+		if endpointReport.Err != nil {
+			fmt.Fprintf(&builder, "%s: %s\n", endpointReport.Endpoint, endpointReport.Err)
+		}
+		for _, field := range endpointReport.Added {
+			fmt.Fprintf(&builder, "%s: added %s (%s)\n", endpointReport.Endpoint, field.Path, field.Actual)
+		}
+		for _, field := range endpointReport.Removed {
+			fmt.Fprintf(&builder, "%s: removed %s (%s)\n", endpointReport.Endpoint, field.Path, field.Expected)
+		}
+		for _, field := range endpointReport.Changed {
+			fmt.Fprintf(&builder, "%s: changed %s from %s to %s\n", endpointReport.Endpoint, field.Path, field.Expected, field.Actual)
+		}
+	}
+
+	return builder.String()
+}
+
+// SchemaCheckOptions are the search terms and strain ID that CheckSchema
+// uses for endpoints that need them.  Zero values are replaced with
+// terms known to return data.
+type SchemaCheckOptions struct {
+	StrainID   int
+	Name       string
+	Race       Race
+	Flavor     Flavor
+	EffectName string
+}
+
+func (o SchemaCheckOptions) withDefaults() SchemaCheckOptions {
+	if o.StrainID == 0 {
+		o.StrainID = 1
+	}
+	if o.Name == "" {
+		o.Name = "Afpak"
+	}
+	if o.Race == "" {
+		o.Race = RaceHybrid
+	}
+	if o.Flavor == "" {
+		o.Flavor = "Earthy"
+	}
+	if o.EffectName == "" {
+		o.EffectName = "Happy"
+	}
+
+	return o
+}
+
+// CheckSchema calls every endpoint through the client's
+// HandleResourceRequestFunc and reports which fields were added, removed or
+// changed type compared to the client's types.  To check recorded responses
+// instead of the live API, set a RecordedResponses handler first.
+func (c *DefaultClient) CheckSchema(options SchemaCheckOptions) SchemaReport {
+	options = options.withDefaults()
+
+	resourcePaths := []struct {
+		endpoint     string
+		resourcePath string
+	}{
+		{endpointListAllEffects, "/searchdata/effects"},
+		{endpointListAllFlavors, "/searchdata/flavors"},
+		{endpointListAllStrains, strainSearchBasePath + "/all"},
+		{endpointSearchStrainsByName, strainSearchBasePath + "/name/" + options.Name},
+		{endpointSearchStrainsByRace, strainSearchBasePath + "/race/" + url.PathEscape(string(options.Race))},
+		{endpointSearchStrainsByFlavor, strainSearchBasePath + "/flavor/" + url.PathEscape(string(options.Flavor))},
+		{endpointSearchStrainsByEffectName, strainSearchBasePath + "/effect/" + url.PathEscape(options.EffectName)},
+		{endpointGetStrainDescriptionByStrainID, fmt.Sprintf("%s/%s/%d", strainDataBasePath, "desc", options.StrainID)},
+		{endpointGetStrainFlavorsByStrainID, fmt.Sprintf("%s/%s/%d", strainDataBasePath, "flavors", options.StrainID)},
+		{endpointGetStrainEffectsByStrainID, fmt.Sprintf("%s/%s/%d", strainDataBasePath, "effects", options.StrainID)},
+	}
+
+	report := make(SchemaReport, 0, len(resourcePaths))
+
+	for _, resource := range resourcePaths {
+		endpointReport := EndpointSchemaReport{Endpoint: resource.endpoint, ResourcePath: resource.resourcePath}
+
+		data, err := c.simpleHTTPGet(c.context(), resource.resourcePath)
+		if err == nil {
+			endpointReport.Added, endpointReport.Removed, endpointReport.Changed, err = compareWithSchema(resource.endpoint, data)
+		}
+		endpointReport.Err = err
+
+		report = append(report, endpointReport)
+	}
+
+	return report
+}
+
+// SchemaError is returned in strict decoding mode when a response contains
+// fields the client does not know about or fields of an unexpected type.
+type SchemaError struct {
+	Endpoint string
+	Added    []SchemaField
+	Changed  []SchemaField
+}
+
+func (e *SchemaError) Error() string {
+	problems := make([]string, 0, len(e.Added)+len(e.Changed))
+
+	for _, field := range e.Added {
+		problems = append(problems, fmt.Sprintf("unknown field %s (%s)", field.Path, field.Actual))
+	}
+	for _, field := range e.Changed {
+		problems = append(problems, fmt.Sprintf("field %s is %s, expected %s", field.Path, field.Actual, field.Expected))
+	}
+
+	return fmt.Sprintf("Unexpected response from %s: %s", e.Endpoint, strings.Join(problems, "; "))
+}
+
+// SetStrictDecoding turns strict decoding of responses on or off and returns
+// the previous setting.  In strict mode, responses with unknown fields or
+// fields of an unexpected type are rejected with a *SchemaError instead of
+// being silently ignored or zeroed.
+func (c *DefaultClient) SetStrictDecoding(strict bool) bool {
+	current := c.strictDecoding
+	c.strictDecoding = strict
+	return current
+}
+
+// unmarshal decodes the response from endpoint into v and reports any error
+// to the MetricsCollector.
+func (c *DefaultClient) unmarshal(endpoint string, data []byte, v interface{}) error {
+	err := c.decode(endpoint, data, v)
+	c.observeError(endpoint, err)
+	return err
+}
+
+// decode decodes the response from endpoint into v, first checking its
+// shape when strict decoding is on, and then its races and effect types
+// when strict enums are on.
+func (c *DefaultClient) decode(endpoint string, data []byte, v interface{}) error {
+	if c.strictDecoding {
+		added, _, changed, err := compareWithSchema(endpoint, data)
+		if err != nil {
+			return err
+		}
+
+		if len(added) > 0 || len(changed) > 0 {
+			return &SchemaError{Endpoint: endpoint, Added: added, Changed: changed}
+		}
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	if c.strictEnums {
+		return checkEnums(reflect.ValueOf(v))
+	}
+	return nil
+}
+
+// compareWithSchema compares the JSON in data with the schema of endpoint.
+func compareWithSchema(endpoint string, data []byte) (added []SchemaField, removed []SchemaField, changed []SchemaField, err error) {
+	schema := endpointSchemas[endpoint]
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, nil, nil, fmt.Errorf("Problem parsing response from %s: %s", endpoint, err)
+	}
+
+	observed := make(map[string]map[string]bool)
+	observeJSONTypes(schema.fields, observed, "", value)
+
+	for _, path := range sortedKeys(observed) {
+		expectedType, known := schema.fields[path]
+		actual := observed[path]
+		if schema.nullable[path] && actual["null"] {
+			actual = withoutNull(actual)
+			if len(actual) == 0 {
+				continue
+			}
+		}
+		actualTypes := strings.Join(sortedKeys(actual), "|")
+
+		switch {
+		case !known:
+			added = append(added, SchemaField{Path: path, Actual: actualTypes})
+		case len(actual) > 1 || !actual[expectedType]:
+			changed = append(changed, SchemaField{Path: path, Expected: expectedType, Actual: actualTypes})
+		}
+	}
+
+	expectedPaths := make([]string, 0, len(schema.fields))
+	for path := range schema.fields {
+		expectedPaths = append(expectedPaths, path)
+	}
+	sort.Strings(expectedPaths)
+
+	for _, path := range expectedPaths {
+		parent, isObjectField := parentOfObjectField(path)
+		// Fields can only be missing from objects that were actually seen
+		if !isObjectField || schema.optional[path] || observed[path] != nil || !observed[parent]["object"] {
+			continue
+		}
+
+		removed = append(removed, SchemaField{Path: path, Expected: schema.fields[path]})
+	}
+
+	return added, removed, changed, nil
+}
+
+// withoutNull returns jsonTypes without "null".
+func withoutNull(jsonTypes map[string]bool) map[string]bool {
+	withoutNull := make(map[string]bool, len(jsonTypes))
+	for jsonType := range jsonTypes {
+		if jsonType != "null" {
+			withoutNull[jsonType] = true
+		}
+	}
+	return withoutNull
+}
+
+// observeJSONTypes records the JSON type of every value found under path.
+// Objects are treated as maps when the schema expects one at path.
+func observeJSONTypes(schemaFields map[string]string, observed map[string]map[string]bool, path string, value interface{}) {
+	addObserved := func(jsonType string) {
+		if observed[path] == nil {
+			observed[path] = make(map[string]bool)
+		}
+		observed[path][jsonType] = true
+	}
+
+	switch typedValue := value.(type) {
+	case nil:
+		addObserved("null")
+	case string:
+		addObserved("string")
+	case bool:
+		addObserved("boolean")
+	case float64:
+		addObserved("number")
+	case []interface{}:
+		addObserved("array")
+		for _, element := range typedValue {
+			observeJSONTypes(schemaFields, observed, path+"[]", element)
+		}
+	case map[string]interface{}:
+		addObserved("object")
+		_, isMap := schemaFields[path+"{*}"]
+		for key, element := range typedValue {
+			if isMap {
+				observeJSONTypes(schemaFields, observed, path+"{*}", element)
+			} else {
+				observeJSONTypes(schemaFields, observed, path+"."+key, element)
+			}
+		}
+	}
+}
+
+// parentOfObjectField returns the path of the object that contains the
+// named field at path.
+func parentOfObjectField(path string) (string, bool) {
+	lastDot := strings.LastIndex(path, ".")
+	if lastDot < 0 || strings.HasSuffix(path, "[]") || strings.HasSuffix(path, "{*}") {
+		return "", false
+	}
+
+	return path[:lastDot], true
+}
+
+func sortedKeys(m interface{}) []string {
+	keys := make([]string, 0)
+	for _, key := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, key.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// RecordedResponses holds response bodies keyed by resource path (the part of
+// the URL after the API Key, such as "/searchdata/effects").
+type RecordedResponses map[string][]byte
+
+// Record wraps next so that every successful response is stored in r.
+func (r RecordedResponses) Record(next HandleResourceRequestFunc) HandleResourceRequestFunc {
+	return func(resourcePath string) ([]byte, error) {
+		body, err := next(resourcePath)
+		if err == nil {
+			r[resourcePathWithoutAPIKey(resourcePath)] = body
+		}
+		return body, err
+	}
+}
+
+// HandleResourceRequest is a HandleResourceRequestFunc that replays the
+// recorded responses.
+func (r RecordedResponses) HandleResourceRequest(resourcePath string) ([]byte, error) {
+	body, found := r[resourcePathWithoutAPIKey(resourcePath)]
+	if !found {
+		return make([]byte, 0), fmt.Errorf("No recorded response for %s", resourcePathWithoutAPIKey(resourcePath))
+	}
+
+	return body, nil
+}
+
+// resourcePathWithoutAPIKey strips the scheme, host and API Key from a
+// full resource URL.
+func resourcePathWithoutAPIKey(resourcePath string) string {
+	parsedURL, err := url.Parse(resourcePath)
+	if err != nil {
+		return resourcePath
+	}
+
+	path := strings.TrimPrefix(parsedURL.Path, "/")
+	if slash := strings.Index(path, "/"); slash >= 0 {
+		return path[slash:]
+	}
+
+	return ""
+}
+
+// apiKeyOfResourcePath returns the API Key in a resource path, the first
+// segment of its path.
+func apiKeyOfResourcePath(resourcePath string) string {
+	parsedURL, err := url.Parse(resourcePath)
+	if err != nil {
+		return ""
+	}
+
+	path := strings.TrimPrefix(parsedURL.Path, "/")
+	if slash := strings.Index(path, "/"); slash >= 0 {
+		return path[:slash]
+	}
+	return path
+}
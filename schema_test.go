@@ -0,0 +1,139 @@
+package strainapiclient
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func recordedResponsesForSchemaTests() RecordedResponses {
+	return RecordedResponses{
+		"/searchdata/effects":            []byte(`[{"effect":"Relaxed","type":"positive"}]`),
+		"/searchdata/flavors":            []byte(`["Earthy","Pine"]`),
+		"/strains/search/all":            []byte(`{"Afpak":{"id":1,"race":"hybrid","flavors":["Earthy"],"effects":{"positive":["Relaxed"]}}}`),
+		"/strains/search/name/Afpak":     []byte(`[{"id":1,"name":"Afpak","race":"hybrid","desc":"A hybrid."}]`),
+		"/strains/search/race/hybrid":    []byte(`[{"id":1,"name":"Afpak","race":"hybrid"}]`),
+		"/strains/search/flavor/Earthy":  []byte(`[{"id":1,"name":"Afpak","race":"hybrid","flavor":"Earthy"}]`),
+		"/strains/search/effect/Happy":   []byte(`[{"id":1,"name":"Afpak","race":"hybrid","effect":"Happy"}]`),
+		"/strains/data/desc/1":           []byte(`{"desc":"A hybrid."}`),
+		"/strains/data/flavors/1":        []byte(`["Earthy"]`),
+		"/strains/data/effects/1":        []byte(`{"positive":["Relaxed"],"negative":[],"medical":["Pain"]}`),
+		"/strains/search/name/Not Found": []byte(`[]`),
+	}
+}
+
+func TestCheckSchemaWithoutDrift(t *testing.T) {
+	client := NewDefaultClient("schema-test")
+	client.SetHandleResourceRequestFunc(recordedResponsesForSchemaTests().HandleResourceRequest)
+
+	report := client.CheckSchema(SchemaCheckOptions{})
+
+	if len(report) != len(endpointSchemas) {
+		t.Errorf("Expected a report for each of the %d endpoints, got %d", len(endpointSchemas), len(report))
+	}
+
+	if report.HasDrift() {
+		t.Errorf("Expected no drift, got:\n%s", report)
+	}
+}
+
+func TestCheckSchemaReportsDrift(t *testing.T) {
+	responses := recordedResponsesForSchemaTests()
+	responses["/strains/search/all"] = []byte(`{"Afpak":{"id":"1","flavors":["Earthy"],"effects":{"positive":["Relaxed"]},"thc":18.5}}`)
+	responses["/strains/data/effects/1"] = []byte(`{"positive":[{"effect":"Relaxed"}]}`)
+
+	client := NewDefaultClient("schema-test")
+	client.SetHandleResourceRequestFunc(responses.HandleResourceRequest)
+
+	report := client.CheckSchema(SchemaCheckOptions{})
+
+	allStrains := report[2]
+	if allStrains.Endpoint != endpointListAllStrains {
+		t.Fatalf("Expected the third report to be for %s, got %s", endpointListAllStrains, allStrains.Endpoint)
+	}
+
+	expectedAdded := []SchemaField{{Path: "{*}.thc", Actual: "number"}}
+	expectedRemoved := []SchemaField{{Path: "{*}.race", Expected: "string"}}
+	expectedChanged := []SchemaField{{Path: "{*}.id", Expected: "number", Actual: "string"}}
+
+	if !equalSchemaFields(allStrains.Added, expectedAdded) ||
+		!equalSchemaFields(allStrains.Removed, expectedRemoved) ||
+		!equalSchemaFields(allStrains.Changed, expectedChanged) {
+		t.Errorf("Unexpected drift report for %s:\n%s", endpointListAllStrains, report)
+	}
+
+	effects := report[len(report)-1]
+	expectedChanged = []SchemaField{{Path: "{*}[]", Expected: "string", Actual: "object"}}
+	if !equalSchemaFields(effects.Changed, expectedChanged) || len(effects.Added) != 1 {
+		t.Errorf("Unexpected drift report for %s:\n%s", endpointGetStrainEffectsByStrainID, report)
+	}
+}
+
+func TestCheckSchemaReportsRequestErrors(t *testing.T) {
+	client := NewDefaultClient("schema-test")
+	client.SetHandleResourceRequestFunc(RecordedResponses{}.HandleResourceRequest)
+
+	report := client.CheckSchema(SchemaCheckOptions{})
+
+	for _, endpointReport := range report {
+		if endpointReport.Err == nil {
+			t.Errorf("Expected an error for %s with no recorded responses", endpointReport.Endpoint)
+		}
+	}
+}
+
+func TestStrictDecoding(t *testing.T) {
+	responses := recordedResponsesForSchemaTests()
+	responses["/strains/data/desc/1"] = []byte(`{"desc":"A hybrid.","updated":"2020-06-29"}`)
+
+	client := NewDefaultClient("schema-test")
+	client.SetHandleResourceRequestFunc(responses.HandleResourceRequest)
+
+	description, err := client.GetStrainDescriptionByStrainID(1)
+	if err != nil || description != "A hybrid." {
+		t.Errorf("Expected lenient decoding to ignore the unknown field, got %q and %v", description, err)
+	}
+
+	if previous := client.SetStrictDecoding(true); previous {
+		t.Error("Expected strict decoding to be off by default")
+	}
+
+	_, err = client.GetStrainDescriptionByStrainID(1)
+
+	var schemaErr *SchemaError
+	if !errors.As(err, &schemaErr) || !strings.Contains(err.Error(), ".updated") {
+		t.Errorf("Expected a *SchemaError about the unknown field, got %v", err)
+	}
+
+	if _, err := client.ListAllStrains(); err != nil {
+		t.Errorf("Expected strict decoding to accept the names and descriptions the client fills in, got %s", err)
+	}
+
+	if _, err := client.SearchStrainsByName("Not Found"); err != nil {
+		t.Errorf("Expected strict decoding to accept an empty result, got %s", err)
+	}
+
+	responses["/strains/data/desc/1"] = []byte(`{"desc":null}`)
+	if _, err := client.GetStrainDescriptionByStrainID(1); !errors.Is(err, ErrDescriptionNotFound) {
+		t.Errorf("Expected strict decoding to accept a null description as a missing one, got %v", err)
+	}
+
+	responses["/strains/data/desc/1"] = []byte(`{"desc":1}`)
+	if _, err := client.GetStrainDescriptionByStrainID(1); !errors.As(err, &schemaErr) {
+		t.Errorf("Expected strict decoding to still reject a description that is not a string, got %v", err)
+	}
+}
+
+func equalSchemaFields(actual []SchemaField, expected []SchemaField) bool {
+	if len(actual) != len(expected) {
+		return false
+	}
+
+	for index := range actual {
+		if actual[index] != expected[index] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,39 @@
+package strainapiclient
+
+import (
+	"errors"
+	"fmt"
+)
+
+// GetStrainByID returns the full Strain, including its description, for the
+// id passed in.  The API only has names and races in the list of all
+// strains, so this calls ListAllStrains (which is expensive; consider
+// caching the client's responses with a ResponseCache).
+func GetStrainByID(client Client, id int) (Strain, error) {
+	allStrains, err := client.ListAllStrains()
+	if err != nil {
+		return Strain{}, err
+	}
+
+	return strainFromList(client, allStrains, id)
+}
+
+// strainFromList returns the strain with id from allStrains, with its
+// description.
+func strainFromList(client Client, allStrains ListAllStrainsResult, id int) (Strain, error) {
+	for _, strain := range allStrains {
+		if strain.ID != id {
+			continue
+		}
+
+		description, err := client.GetStrainDescriptionByStrainID(id)
+		if err != nil && !errors.Is(err, ErrDescriptionNotFound) {
+			return Strain{}, err
+		}
+		strain.Description = description
+
+		return strain, nil
+	}
+
+	return Strain{}, fmt.Errorf("%w: no strain with ID %d", ErrStrainNotFound, id)
+}
@@ -3,11 +3,13 @@
 package strainapiclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 const baseURLHost string = "strainapi.evanbusse.com"
@@ -38,31 +40,104 @@ type HandleResourceRequestFunc func(resourcePath string) ([]byte, error)
 // DefaultClient is the default implementation of a Client for The Strain API
 type DefaultClient struct {
 	apiKey                     string
+	baseURL                    string
 	resourceRequestHandlerFunc HandleResourceRequestFunc
+	middlewares                []Middleware
+	chainedHandlerFunc         HandleResourceRequestFunc
+	reach                      *handlerReach
+	strictDecoding             bool
+	strictEnums                bool
+	logger                     Logger
+	metrics                    MetricsCollector
+	tracer                     Tracer
+	ctx                        context.Context
 }
 
 // NewDefaultClient creates a new DefaultClient with the apiKey passed in.
 func NewDefaultClient(apiKey string) *DefaultClient {
-	client := &DefaultClient{apiKey: apiKey}
-	client.resourceRequestHandlerFunc = simpleHTTPGetForFullPath
+	client := &DefaultClient{apiKey: apiKey, baseURL: baseURL, reach: &handlerReach{pending: make(map[string][]*tracedRequest)}}
+	client.SetHandleResourceRequestFunc(simpleHTTPGetForFullPath)
 	return client
 }
 
 // SetHandleResourceRequestFunc sets a new request handler for the DefaultClient
 // (including any custom function that matches the HandleResrourceRequestFunc signature)
-// and returns the value that was previously used.
+// and returns the value that was previously used.  Middlewares added with Use
+// stay in place around the new handler.
 func (c *DefaultClient) SetHandleResourceRequestFunc(f HandleResourceRequestFunc) HandleResourceRequestFunc {
 	current := c.resourceRequestHandlerFunc
 	c.resourceRequestHandlerFunc = f
+	c.chainedHandlerFunc = Chain(c.middlewares...)(c.reach.wrap(f))
 	return current
 }
 
+// Use adds middlewares around the request handler of the DefaultClient.
+// Requests pass through the middlewares in the order they are added, so the
+// first middleware ever added sees every request first, and the handler set
+// with SetHandleResourceRequestFunc is always called last.
+func (c *DefaultClient) Use(middlewares ...Middleware) {
+	c.middlewares = append(c.middlewares, middlewares...)
+	c.chainedHandlerFunc = Chain(c.middlewares...)(c.reach.wrap(c.resourceRequestHandlerFunc))
+}
+
+// SetBaseURL sets the URL (scheme and host, without a trailing '/') the
+// DefaultClient sends requests to, such as a mirror of the API, and returns
+// the value that was previously used.
+func (c *DefaultClient) SetBaseURL(baseURL string) string {
+	current := c.baseURL
+	c.baseURL = baseURL
+	return current
+}
+
+// GetResource returns the raw response for a resource path of the API
+// (the part of the URL after the API Key, with a leading '/'), using the
+// same HandleResourceRequestFunc as every other call.
+func (c *DefaultClient) GetResource(resourcePath string) ([]byte, error) {
+	return c.simpleHTTPGet(c.context(), resourcePath)
+}
+
 // simpleHTTPGet is just a simple wrapper for getting basic
 // byte slices from an HTTP GET call.
 // It uses the base url of the API and appends the string
 // passed in to the path (you must add a leading '/').
-func (c *DefaultClient) simpleHTTPGet(restOfURLPath string) ([]byte, error) {
-	return c.resourceRequestHandlerFunc(baseURL + "/" + c.apiKey + restOfURLPath)
+// The API Key is removed from the message of any error it returns.
+func (c *DefaultClient) simpleHTTPGet(ctx context.Context, restOfURLPath string) ([]byte, error) {
+	resourcePath := c.baseURL + "/" + c.apiKey + restOfURLPath
+
+	var span Span = noopSpan{}
+	var traced *tracedRequest
+	if c.tracer != nil {
+		_, span = c.tracer.Start(ctx, resourceRequestSpanName)
+		span.SetAttribute(AttributeResourcePath, restOfURLPath)
+
+		traced = c.reach.begin(resourcePath)
+	}
+
+	start := time.Now()
+	body, err := c.chainedHandlerFunc(resourcePath)
+	duration := time.Since(start)
+	err = c.redact(err)
+
+	if traced != nil {
+		c.reach.end(resourcePath, traced)
+		span.SetAttribute(AttributeCacheHit, traced.answeredByMiddleware(err))
+	}
+	if status := statusOf(err); status != 0 {
+		span.SetAttribute(AttributeStatusCode, status)
+	}
+	_ = endSpan(span, err)
+
+	if c.logger != nil {
+		c.logRequest(restOfURLPath, duration, body, err)
+	}
+
+	if c.metrics != nil {
+		endpoint := endpointForPath(restOfURLPath)
+		c.metrics.ObserveRequest(endpoint, duration)
+		c.observeError(endpoint, err)
+	}
+
+	return body, err
 }
 
 // simpleHTTPGetForFullPath is the default implementation of a
@@ -72,6 +147,9 @@ func (c *DefaultClient) simpleHTTPGet(restOfURLPath string) ([]byte, error) {
 // and set it using the SetHandleResourceRequestFunc() function.
 func simpleHTTPGetForFullPath(path string) ([]byte, error) {
 	req, err := http.NewRequest("GET", path, nil)
+	if err != nil {
+		return make([]byte, 0), &ConnectionError{Err: redactURLError(err)}
+	}
 	req.Header.Set("Host", baseURLHost)
 	req.Header.Set("User-Agent", "strain-api-client-go/v1")
 
@@ -81,8 +159,7 @@ func simpleHTTPGetForFullPath(path string) ([]byte, error) {
 
 	resp, err := client.Do(req)
 	if err != nil {
-		specificError := fmt.Errorf("There was a problem connecting to the api: %s", err)
-		return make([]byte, 0), specificError
+		return make([]byte, 0), &ConnectionError{Err: redactURLError(err)}
 	}
 
 	defer resp.Body.Close()
@@ -90,12 +167,12 @@ func simpleHTTPGetForFullPath(path string) ([]byte, error) {
 	body, bodyErr := ioutil.ReadAll(resp.Body)
 
 	if resp.StatusCode != http.StatusOK {
-		return make([]byte, 0), fmt.Errorf("Status: %d - %s", resp.StatusCode, string(body))
+		return make([]byte, 0), &StatusError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
-	if bodyErr != nil || err != nil {
-		parsingError := fmt.Errorf("There was a problem reading the body of the response: %s", err)
-		return make([]byte, 0), parsingError
+	if bodyErr != nil {
+		parsingError := fmt.Errorf("There was a problem reading the body of the response: %w", bodyErr)
+		return make([]byte, 0), &ConnectionError{Err: parsingError}
 	}
 
 	return body, nil
@@ -105,7 +182,7 @@ func simpleHTTPGetForFullPath(path string) ([]byte, error) {
 // and makes sure it gets back the default response from the API.
 func (c *DefaultClient) CanConnect() bool {
 	// Expected response: Seems legit to me man...
-	body, _ := c.simpleHTTPGet("")
+	body, _ := c.simpleHTTPGet(c.context(), "")
 	return string(body) == "Seems legit to me man..."
 }
 
@@ -124,22 +201,29 @@ const (
 	// EffectTypePositive represents positive effects
 	EffectTypePositive EffectType = "positive"
 	// EffectTypeNegative represents negative effects
-	EffectTypeNegative = "negative"
+	EffectTypeNegative EffectType = "negative"
 	// EffectTypeMedical represents possible medical-related effects
-	EffectTypeMedical = "medical"
+	EffectTypeMedical EffectType = "medical"
 )
 
 // ListAllEffects returns a slice of Effect elements that
 // represents all effects that can be experienced.
 func (c *DefaultClient) ListAllEffects() ([]Effect, error) {
+	ctx, span := c.startSpan(endpointListAllEffects)
+
+	result, err := c.listAllEffects(ctx)
+	return result, endSpan(span, err)
+}
+
+func (c *DefaultClient) listAllEffects(ctx context.Context) ([]Effect, error) {
 	effects := make([]Effect, 0)
 
-	allEffectsJSONBytes, err := c.simpleHTTPGet("/searchdata/effects")
+	allEffectsJSONBytes, err := c.simpleHTTPGet(ctx, "/searchdata/effects")
 	if err != nil {
 		return effects, err
 	}
 
-	marshallErr := json.Unmarshal(allEffectsJSONBytes, &effects)
+	marshallErr := c.unmarshal(endpointListAllEffects, allEffectsJSONBytes, &effects)
 	return effects, marshallErr
 }
 
@@ -149,14 +233,21 @@ type Flavor string
 // ListAllFlavors returns a slice of Flavor elements that
 // represents all flavors of a strain.
 func (c *DefaultClient) ListAllFlavors() ([]Flavor, error) {
+	ctx, span := c.startSpan(endpointListAllFlavors)
+
+	result, err := c.listAllFlavors(ctx)
+	return result, endSpan(span, err)
+}
+
+func (c *DefaultClient) listAllFlavors(ctx context.Context) ([]Flavor, error) {
 	flavors := make([]Flavor, 0)
 
-	allFlavorsJSONBytes, err := c.simpleHTTPGet("/searchdata/flavors")
+	allFlavorsJSONBytes, err := c.simpleHTTPGet(ctx, "/searchdata/flavors")
 	if err != nil {
 		return flavors, err
 	}
 
-	marshallErr := json.Unmarshal(allFlavorsJSONBytes, &flavors)
+	marshallErr := c.unmarshal(endpointListAllFlavors, allFlavorsJSONBytes, &flavors)
 	return flavors, marshallErr
 }
 
@@ -167,9 +258,9 @@ const (
 	// RaceIndica represents a Race of a strain
 	RaceIndica Race = "indica"
 	// RaceSativa represents a Race of a strain
-	RaceSativa = "sativa"
+	RaceSativa Race = "sativa"
 	// RaceHybrid represents a Race of a strain
-	RaceHybrid = "hybrid"
+	RaceHybrid Race = "hybrid"
 )
 
 // Strain represents a single strain of cannabis and its properites.
@@ -191,16 +282,23 @@ type ListAllStrainsResult map[string]Strain
 // ListAllStrains gets a ListAllStrainsResult of all strains
 // (please use sparingly, it is expensive to run).
 func (c *DefaultClient) ListAllStrains() (ListAllStrainsResult, error) {
+	ctx, span := c.startSpan(endpointListAllStrains)
+
+	result, err := c.listAllStrains(ctx)
+	return result, endSpan(span, err)
+}
+
+func (c *DefaultClient) listAllStrains(ctx context.Context) (ListAllStrainsResult, error) {
 	strainsResults := make(ListAllStrainsResult)
 
 	findAllURL := strainSearchBasePath + "/all"
-	strainsResultsJSONBytes, err := c.simpleHTTPGet(findAllURL)
+	strainsResultsJSONBytes, err := c.simpleHTTPGet(ctx, findAllURL)
 
 	if err != nil {
 		return strainsResults, err
 	}
 
-	marshallErr := json.Unmarshal(strainsResultsJSONBytes, &strainsResults)
+	marshallErr := c.unmarshal(endpointListAllStrains, strainsResultsJSONBytes, &strainsResults)
 
 	populateStrainNames(strainsResults)
 
@@ -232,16 +330,24 @@ type SearchStrainsByNameResults []SearchStrainsByNameResult
 // SearchStrainsByName returns a SearchStrainsByNameResults of all strains matching
 // the name passed in.
 func (c *DefaultClient) SearchStrainsByName(name string) (SearchStrainsByNameResults, error) {
+	ctx, span := c.startSpan(endpointSearchStrainsByName)
+	span.SetAttribute(AttributeSearchTerm, name)
+
+	result, err := c.searchStrainsByName(ctx, name)
+	return result, endSpan(span, err)
+}
+
+func (c *DefaultClient) searchStrainsByName(ctx context.Context, name string) (SearchStrainsByNameResults, error) {
 	strainsResults := make(SearchStrainsByNameResults, 0)
 
 	searchURL := strainSearchBasePath + "/name/" + name
-	strainsResultsJSONBytes, err := c.simpleHTTPGet(searchURL)
+	strainsResultsJSONBytes, err := c.simpleHTTPGet(ctx, searchURL)
 
 	if err != nil {
 		return strainsResults, err
 	}
 
-	marshallErr := json.Unmarshal(strainsResultsJSONBytes, &strainsResults)
+	marshallErr := c.unmarshal(endpointSearchStrainsByName, strainsResultsJSONBytes, &strainsResults)
 
 	return strainsResults, marshallErr
 }
@@ -261,16 +367,24 @@ type SearchStrainsByRaceResults []SearchStrainsByRaceResult
 // SearchStrainsByRace gets a SearchStrainsByRaceResult of all strains matching
 // the Race passed in.
 func (c *DefaultClient) SearchStrainsByRace(race Race) (SearchStrainsByRaceResults, error) {
+	ctx, span := c.startSpan(endpointSearchStrainsByRace)
+	span.SetAttribute(AttributeSearchTerm, string(race))
+
+	result, err := c.searchStrainsByRace(ctx, race)
+	return result, endSpan(span, err)
+}
+
+func (c *DefaultClient) searchStrainsByRace(ctx context.Context, race Race) (SearchStrainsByRaceResults, error) {
 	strainsResults := make(SearchStrainsByRaceResults, 0)
 
 	searchURL := strainSearchBasePath + "/race/" + url.PathEscape(string(race))
-	strainsResultsJSONBytes, err := c.simpleHTTPGet(searchURL)
+	strainsResultsJSONBytes, err := c.simpleHTTPGet(ctx, searchURL)
 
 	if err != nil {
 		return strainsResults, err
 	}
 
-	marshallErr := json.Unmarshal(strainsResultsJSONBytes, &strainsResults)
+	marshallErr := c.unmarshal(endpointSearchStrainsByRace, strainsResultsJSONBytes, &strainsResults)
 
 	return strainsResults, marshallErr
 }
@@ -291,16 +405,24 @@ type SearchStrainsByEffectNameResults []SearchStrainsByEffectNameResult
 // SearchStrainsByEffectName returns a SearchStrainsByEffectNameResults of all strains
 // with an effect that matches the Effect passed in.
 func (c *DefaultClient) SearchStrainsByEffectName(effectName string) (SearchStrainsByEffectNameResults, error) {
+	ctx, span := c.startSpan(endpointSearchStrainsByEffectName)
+	span.SetAttribute(AttributeSearchTerm, effectName)
+
+	result, err := c.searchStrainsByEffectName(ctx, effectName)
+	return result, endSpan(span, err)
+}
+
+func (c *DefaultClient) searchStrainsByEffectName(ctx context.Context, effectName string) (SearchStrainsByEffectNameResults, error) {
 	strainsResults := make(SearchStrainsByEffectNameResults, 0)
 
 	searchURL := strainSearchBasePath + "/effect/" + url.PathEscape(string(effectName))
-	strainsResultsJSONBytes, err := c.simpleHTTPGet(searchURL)
+	strainsResultsJSONBytes, err := c.simpleHTTPGet(ctx, searchURL)
 
 	if err != nil {
 		return strainsResults, err
 	}
 
-	marshallErr := json.Unmarshal(strainsResultsJSONBytes, &strainsResults)
+	marshallErr := c.unmarshal(endpointSearchStrainsByEffectName, strainsResultsJSONBytes, &strainsResults)
 
 	return strainsResults, marshallErr
 }
@@ -321,42 +443,58 @@ type SearchStrainsByFlavorResults []SearchStrainsByFlavorResult
 // SearchStrainsByFlavor returns a SearchStrainsByFlavorResults of all strains
 // with a flavor that matches the Flavor passed in.
 func (c *DefaultClient) SearchStrainsByFlavor(flavor Flavor) (SearchStrainsByFlavorResults, error) {
+	ctx, span := c.startSpan(endpointSearchStrainsByFlavor)
+	span.SetAttribute(AttributeSearchTerm, string(flavor))
+
+	result, err := c.searchStrainsByFlavor(ctx, flavor)
+	return result, endSpan(span, err)
+}
+
+func (c *DefaultClient) searchStrainsByFlavor(ctx context.Context, flavor Flavor) (SearchStrainsByFlavorResults, error) {
 	strainsResults := make(SearchStrainsByFlavorResults, 0)
 
 	searchURL := strainSearchBasePath + "/flavor/" + url.PathEscape(string(flavor))
-	strainsResultsJSONBytes, err := c.simpleHTTPGet(searchURL)
+	strainsResultsJSONBytes, err := c.simpleHTTPGet(ctx, searchURL)
 
 	if err != nil {
 		return strainsResults, err
 	}
 
-	marshallErr := json.Unmarshal(strainsResultsJSONBytes, &strainsResults)
+	marshallErr := c.unmarshal(endpointSearchStrainsByFlavor, strainsResultsJSONBytes, &strainsResults)
 
 	return strainsResults, marshallErr
 }
 
 const strainDataBasePath string = strainsBasePath + "/data"
 
-func (c *DefaultClient) getStrainDataByID(dataElementName string, id int) ([]byte, error) {
+func (c *DefaultClient) getStrainDataByID(ctx context.Context, dataElementName string, id int) ([]byte, error) {
 	url := fmt.Sprintf("%s/%s/%d", strainDataBasePath, dataElementName, id)
 
-	return c.simpleHTTPGet(url)
+	return c.simpleHTTPGet(ctx, url)
 }
 
 // GetStrainDescriptionByStrainID retrieves the Description field for the
 // Strain with the ID passed in.
 func (c *DefaultClient) GetStrainDescriptionByStrainID(id int) (string, error) {
+	ctx, span := c.startSpan(endpointGetStrainDescriptionByStrainID)
+	span.SetAttribute(AttributeStrainID, id)
+
+	result, err := c.getStrainDescriptionByStrainID(ctx, id)
+	return result, endSpan(span, err)
+}
+
+func (c *DefaultClient) getStrainDescriptionByStrainID(ctx context.Context, id int) (string, error) {
 
 	description := ""
-	descriptionResultBytes, err := c.getStrainDataByID("desc", id)
+	descriptionResultBytes, err := c.getStrainDataByID(ctx, "desc", id)
 
 	if err != nil {
-		return "", fmt.Errorf("Problem getting the description for strain with ID %d: %s", id, err)
+		return "", fmt.Errorf("Problem getting the description for strain with ID %d: %w", id, err)
 	}
 
 	result := make(map[string]string)
 
-	marshallErr := json.Unmarshal(descriptionResultBytes, &result)
+	marshallErr := c.unmarshal(endpointGetStrainDescriptionByStrainID, descriptionResultBytes, &result)
 
 	if marshallErr != nil {
 		return "", marshallErr
@@ -365,7 +503,8 @@ func (c *DefaultClient) GetStrainDescriptionByStrainID(id int) (string, error) {
 	description = result["desc"]
 
 	if description == "" {
-		return "", fmt.Errorf("Unable to find description in result")
+		c.observeError(endpointGetStrainDescriptionByStrainID, ErrDescriptionNotFound)
+		return "", ErrDescriptionNotFound
 	}
 
 	return description, nil
@@ -374,16 +513,24 @@ func (c *DefaultClient) GetStrainDescriptionByStrainID(id int) (string, error) {
 // GetStrainFlavorsByStrainID returns a slice of Flavors for
 // the Strain of the id passed in.
 func (c *DefaultClient) GetStrainFlavorsByStrainID(id int) ([]Flavor, error) {
+	ctx, span := c.startSpan(endpointGetStrainFlavorsByStrainID)
+	span.SetAttribute(AttributeStrainID, id)
+
+	result, err := c.getStrainFlavorsByStrainID(ctx, id)
+	return result, endSpan(span, err)
+}
+
+func (c *DefaultClient) getStrainFlavorsByStrainID(ctx context.Context, id int) ([]Flavor, error) {
 	flavors := make([]Flavor, 0)
 
-	flavorsResultBytes, err := c.getStrainDataByID("flavors", id)
+	flavorsResultBytes, err := c.getStrainDataByID(ctx, "flavors", id)
 	if err != nil {
-		return flavors, fmt.Errorf("Problem getting flavors for stain with ID %d: %s", id, err)
+		return flavors, fmt.Errorf("Problem getting flavors for stain with ID %d: %w", id, err)
 	}
 
-	marshallErr := json.Unmarshal(flavorsResultBytes, &flavors)
+	marshallErr := c.unmarshal(endpointGetStrainFlavorsByStrainID, flavorsResultBytes, &flavors)
 	if marshallErr != nil {
-		return flavors, fmt.Errorf("Problem parsing flavors response for string with ID %d: %s\nBytes: %v", id, err, flavorsResultBytes)
+		return flavors, fmt.Errorf("Problem parsing flavors response for string with ID %d: %w\nBytes: %v", id, marshallErr, flavorsResultBytes)
 	}
 
 	return flavors, nil
@@ -396,16 +543,24 @@ type EffectsByEffectType map[EffectType][]Effect
 // Use EffectTypePositive, EffectTypeNegative, and EffectTypeMedical for the keys
 // and the values are a slice of Effect items.
 func (c *DefaultClient) GetStrainEffectsByStrainID(id int) (EffectsByEffectType, error) {
+	ctx, span := c.startSpan(endpointGetStrainEffectsByStrainID)
+	span.SetAttribute(AttributeStrainID, id)
+
+	result, err := c.getStrainEffectsByStrainID(ctx, id)
+	return result, endSpan(span, err)
+}
+
+func (c *DefaultClient) getStrainEffectsByStrainID(ctx context.Context, id int) (EffectsByEffectType, error) {
 	effects := make(EffectsByEffectType)
 
-	effectsResultBytes, err := c.getStrainDataByID("effects", id)
+	effectsResultBytes, err := c.getStrainDataByID(ctx, "effects", id)
 	if err != nil {
-		return effects, fmt.Errorf("Problem retrieving effects for Strain with ID %d: %s", id, err)
+		return effects, fmt.Errorf("Problem retrieving effects for Strain with ID %d: %w", id, err)
 	}
 
-	marshallErr := json.Unmarshal(effectsResultBytes, &effects)
+	marshallErr := c.unmarshal(endpointGetStrainEffectsByStrainID, effectsResultBytes, &effects)
 	if marshallErr != nil {
-		return effects, fmt.Errorf("Problem parsing effects for Strain with ID %d: %s", id, marshallErr)
+		return effects, fmt.Errorf("Problem parsing effects for Strain with ID %d: %w", id, marshallErr)
 	}
 
 	return effects, nil
@@ -440,7 +595,7 @@ func (e EffectsByEffectType) UnmarshalJSON(data []byte) error {
 
 	marshallErr := json.Unmarshal(data, &effectsMap)
 	if marshallErr != nil {
-		return fmt.Errorf("Problem parsing effects for Strain: %s", marshallErr)
+		return fmt.Errorf("Problem parsing effects for Strain: %w", marshallErr)
 	}
 
 	for effectTypeString, effectNames := range effectsMap {
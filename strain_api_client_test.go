@@ -321,8 +321,21 @@ func TestSetHandleResourceReqeustFunc(t *testing.T) {
 	effectName := "Test Effect Name"
 	expectedPath := fmt.Sprintf("https://%s/%s%s/effect/%s", baseURLHost, apiKey, strainSearchBasePath, url.PathEscape(effectName))
 
+	var handledPath string
+	_ = client.SetHandleResourceRequestFunc(func(path string) ([]byte, error) {
+		handledPath = path
+		return mockHandler(path)
+	})
+
 	_, err := client.SearchStrainsByEffectName(effectName)
 
+	if handledPath != expectedPath {
+		t.Errorf("Expected the handler to get path: '%s'\nBut got path:  '%s'", expectedPath, handledPath)
+	}
+
+	// The API Key is removed from errors the handler returns.
+	expectedPath = fmt.Sprintf("https://%s/%s%s/effect/%s", baseURLHost, redactedAPIKey, strainSearchBasePath, url.PathEscape(effectName))
+
 	if err == nil {
 		t.Errorf("Expected path: '%s' But got nil.", expectedPath)
 	}
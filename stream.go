@@ -0,0 +1,305 @@
+package strainapiclient
+
+import (
+	"errors"
+	"sort"
+)
+
+// StrainStream yields strains one at a time, so a pipeline can stop early
+// and only do further work, such as getting the description of a strain,
+// for the strains it gets to.  Use it like a bufio.Scanner:
+//
+//	stream := StreamStrainsByRace(client, RaceSativa).Take(10)
+//	for stream.Next() {
+//		fmt.Println(stream.Strain().Name)
+//	}
+//	if err := stream.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+//
+// A StrainStream is not safe for concurrent use.
+type StrainStream struct {
+	next    func() (strain Strain, more bool, err error)
+	onClose func()
+	current Strain
+	err     error
+	done    bool
+}
+
+// newStrainStream creates a StrainStream that gets each strain from next
+// until it returns false or an error, calling onClose when the stream ends
+// or is closed.
+func newStrainStream(next func() (Strain, bool, error), onClose func()) *StrainStream {
+	return &StrainStream{next: next, onClose: onClose}
+}
+
+// Next moves to the next strain, returning false at the end of the stream,
+// when it failed or when it was closed.
+func (s *StrainStream) Next() bool {
+	if s.done {
+		return false
+	}
+
+	strain, more, err := s.next()
+	if err != nil || !more {
+		s.err = err
+		s.Close()
+		return false
+	}
+
+	s.current = strain
+	return true
+}
+
+// Strain returns the strain Next moved to.
+func (s *StrainStream) Strain() Strain {
+	return s.current
+}
+
+// Err returns the error that ended the stream, if any.
+func (s *StrainStream) Err() error {
+	return s.err
+}
+
+// Close ends the stream, and any stream it was made from, early.
+func (s *StrainStream) Close() {
+	if s.done {
+		return
+	}
+
+	s.done = true
+	if s.onClose != nil {
+		s.onClose()
+	}
+}
+
+// Collect reads the rest of the stream into a slice.
+func (s *StrainStream) Collect() ([]Strain, error) {
+	strains := make([]Strain, 0)
+	for s.Next() {
+		strains = append(strains, s.Strain())
+	}
+	return strains, s.Err()
+}
+
+// Channel sends the rest of the stream on the returned channel from a new
+// goroutine, closing it at the end of the stream.  Close done to stop
+// early.  Check Err once the channel is closed.
+func (s *StrainStream) Channel(done <-chan struct{}) <-chan Strain {
+	strains := make(chan Strain)
+
+	go func() {
+		defer close(strains)
+		defer s.Close()
+
+		for s.Next() {
+			select {
+			case strains <- s.Strain():
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return strains
+}
+
+// Filter returns a stream of the strains for which keep returns true.
+func (s *StrainStream) Filter(keep func(strain Strain) bool) *StrainStream {
+	return newStrainStream(func() (Strain, bool, error) {
+		for s.Next() {
+			if keep(s.Strain()) {
+				return s.Strain(), true, nil
+			}
+		}
+		return Strain{}, false, s.Err()
+	}, s.Close)
+}
+
+// Map returns a stream of the strains returned by f for each strain, ending
+// with the first error it returns.
+func (s *StrainStream) Map(f func(strain Strain) (Strain, error)) *StrainStream {
+	return newStrainStream(func() (Strain, bool, error) {
+		if !s.Next() {
+			return Strain{}, false, s.Err()
+		}
+
+		strain, err := f(s.Strain())
+		if err != nil {
+			return Strain{}, false, err
+		}
+		return strain, true, nil
+	}, s.Close)
+}
+
+// Take returns a stream of at most the first n strains, closing s once it
+// has them.
+func (s *StrainStream) Take(n int) *StrainStream {
+	taken := 0
+
+	return newStrainStream(func() (Strain, bool, error) {
+		if taken >= n || !s.Next() {
+			return Strain{}, false, s.Err()
+		}
+
+		taken++
+		return s.Strain(), true, nil
+	}, s.Close)
+}
+
+// Hydrate fills in the description, flavors and effects of each strain with
+// client, as a function for Map.  Only the strains that reach it are
+// requested.  Strains without a description keep an empty one.
+func Hydrate(client Client) func(strain Strain) (Strain, error) {
+	return func(strain Strain) (Strain, error) {
+		description, err := client.GetStrainDescriptionByStrainID(strain.ID)
+		if err != nil && !errors.Is(err, ErrDescriptionNotFound) {
+			return strain, err
+		}
+		strain.Description = description
+
+		flavors, err := client.GetStrainFlavorsByStrainID(strain.ID)
+		if err != nil {
+			return strain, err
+		}
+		strain.Flavors = flavors
+
+		effects, err := client.GetStrainEffectsByStrainID(strain.ID)
+		if err != nil {
+			return strain, err
+		}
+		strain.Effects = make(map[EffectType][]string, len(effects))
+		for effectType, effectsOfType := range effects {
+			names := make([]string, len(effectsOfType))
+			for index, effect := range effectsOfType {
+				names[index] = effect.Name
+			}
+			strain.Effects[effectType] = names
+		}
+
+		return strain, nil
+	}
+}
+
+// StreamStrains returns a stream of strains.
+func StreamStrains(strains []Strain) *StrainStream {
+	index := 0
+
+	return newStrainStream(func() (Strain, bool, error) {
+		if index >= len(strains) {
+			return Strain{}, false, nil
+		}
+
+		index++
+		return strains[index-1], true, nil
+	}, nil)
+}
+
+// streamFrom returns a stream of the strains returned by search, which is
+// only called when the stream is first read.
+func streamFrom(search func() ([]Strain, error)) *StrainStream {
+	var strains *StrainStream
+
+	return newStrainStream(func() (Strain, bool, error) {
+		if strains == nil {
+			found, err := search()
+			if err != nil {
+				return Strain{}, false, err
+			}
+			strains = StreamStrains(found)
+		}
+
+		if !strains.Next() {
+			return Strain{}, false, nil
+		}
+		return strains.Strain(), true, nil
+	}, nil)
+}
+
+// StreamAllStrains returns a stream of every strain from ListAllStrains,
+// in order of ID.  Strains have their name and race, but not their
+// description.
+func StreamAllStrains(client Client) *StrainStream {
+	return streamFrom(func() ([]Strain, error) {
+		results, err := client.ListAllStrains()
+		if err != nil {
+			return nil, err
+		}
+
+		strains := make([]Strain, 0, len(results))
+		for _, strain := range results {
+			strains = append(strains, strain)
+		}
+		sort.Slice(strains, func(i, j int) bool { return strains[i].ID < strains[j].ID })
+
+		return strains, nil
+	})
+}
+
+// StreamStrainsByName returns a stream of the strains from
+// SearchStrainsByName, with their name, race and description.
+func StreamStrainsByName(client Client, name string) *StrainStream {
+	return streamFrom(func() ([]Strain, error) {
+		results, err := client.SearchStrainsByName(name)
+		if err != nil {
+			return nil, err
+		}
+
+		strains := make([]Strain, len(results))
+		for index, result := range results {
+			strains[index] = Strain{Name: result.Name, ID: result.ID, Race: result.Race, Description: result.Description}
+		}
+		return strains, nil
+	})
+}
+
+// StreamStrainsByRace returns a stream of the strains from
+// SearchStrainsByRace, with their name and race.
+func StreamStrainsByRace(client Client, race Race) *StrainStream {
+	return streamFrom(func() ([]Strain, error) {
+		results, err := client.SearchStrainsByRace(race)
+		if err != nil {
+			return nil, err
+		}
+
+		strains := make([]Strain, len(results))
+		for index, result := range results {
+			strains[index] = Strain{Name: result.Name, ID: result.ID, Race: result.Race}
+		}
+		return strains, nil
+	})
+}
+
+// StreamStrainsByFlavor returns a stream of the strains from
+// SearchStrainsByFlavor, with their name and race.
+func StreamStrainsByFlavor(client Client, flavor Flavor) *StrainStream {
+	return streamFrom(func() ([]Strain, error) {
+		results, err := client.SearchStrainsByFlavor(flavor)
+		if err != nil {
+			return nil, err
+		}
+
+		strains := make([]Strain, len(results))
+		for index, result := range results {
+			strains[index] = Strain{Name: result.Name, ID: result.ID, Race: result.Race}
+		}
+		return strains, nil
+	})
+}
+
+// StreamStrainsByEffectName returns a stream of the strains from
+// SearchStrainsByEffectName, with their name and race.
+func StreamStrainsByEffectName(client Client, effectName string) *StrainStream {
+	return streamFrom(func() ([]Strain, error) {
+		results, err := client.SearchStrainsByEffectName(effectName)
+		if err != nil {
+			return nil, err
+		}
+
+		strains := make([]Strain, len(results))
+		for index, result := range results {
+			strains[index] = Strain{Name: result.Name, ID: result.ID, Race: result.Race}
+		}
+		return strains, nil
+	})
+}
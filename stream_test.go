@@ -0,0 +1,107 @@
+package strainapiclient_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+func strainNames(strains []strainapiclient.Strain) []string {
+	names := make([]string, len(strains))
+	for index, strain := range strains {
+		names[index] = strain.Name
+	}
+	return names
+}
+
+func TestStrainStreamPipeline(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+
+	requests := make([]string, 0)
+	client.Use(recordingResourcePaths(&requests))
+
+	stream := strainapiclient.StreamAllStrains(client).
+		Filter(func(strain strainapiclient.Strain) bool { return strain.Race != strainapiclient.RaceHybrid }).
+		Take(2).
+		Map(strainapiclient.Hydrate(client))
+
+	if len(requests) != 0 {
+		t.Fatalf("Expected no requests before the stream is read, got %v", requests)
+	}
+
+	strains, err := stream.Collect()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dataset := clienttest.KnownDataset()
+	expected := []strainapiclient.Strain{dataset.Strains[1], dataset.Strains[2]}
+	if !reflect.DeepEqual(strains, expected) {
+		t.Errorf("Expected the first 2 strains that are not hybrids, hydrated, got %+v", strains)
+	}
+
+	// One request for the list, and three for each strain that was taken.
+	if len(requests) != 7 {
+		t.Errorf("Expected only the strains taken to be hydrated, got requests %v", requests)
+	}
+}
+
+func TestStrainStreamChannel(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+
+	stream := strainapiclient.StreamStrainsByFlavor(client, "Earthy")
+	names := make([]string, 0)
+	for strain := range stream.Channel(nil) {
+		names = append(names, strain.Name)
+	}
+
+	if err := stream.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(names, []string{"Afpak", "Afghani", "Sour Diesel"}) {
+		t.Errorf("Expected the strains with the Earthy flavor, got %v", names)
+	}
+
+	done := make(chan struct{})
+	strains := strainapiclient.StreamStrainsByRace(client, strainapiclient.RaceIndica).Channel(done)
+	<-strains
+	close(done)
+	for range strains {
+	}
+}
+
+func TestStrainStreamErrors(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+	client.Use(strainapiclient.StubMiddleware(strainapiclient.Stub{Path: "/strains/search/name/*", Err: errors.New("stubbed")}))
+
+	stream := strainapiclient.StreamStrainsByName(client, "Af")
+	if stream.Next() || stream.Err() == nil {
+		t.Errorf("Expected the failed search to end the stream with its error, got %v", stream.Err())
+	}
+
+	mapErr := errors.New("map failed")
+	strains, err := strainapiclient.StreamStrainsByEffectName(client, "Happy").
+		Map(func(strain strainapiclient.Strain) (strainapiclient.Strain, error) {
+			if strain.ID == 3 {
+				return strain, mapErr
+			}
+			return strain, nil
+		}).
+		Collect()
+
+	if err != mapErr || !reflect.DeepEqual(strainNames(strains), []string{"Afpak", "Afghani"}) {
+		t.Errorf("Expected the strains before the error and the error, got %v, %v", strainNames(strains), err)
+	}
+}
+
+func recordingResourcePaths(paths *[]string) strainapiclient.Middleware {
+	return func(next strainapiclient.HandleResourceRequestFunc) strainapiclient.HandleResourceRequestFunc {
+		return func(resourcePath string) ([]byte, error) {
+			*paths = append(*paths, resourcePath)
+			return next(resourcePath)
+		}
+	}
+}
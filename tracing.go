@@ -0,0 +1,184 @@
+package strainapiclient
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// Tracer starts spans, such as those of OpenTelemetry, without this module
+// depending on any tracing library: adapt yours to it with a few lines.
+type Tracer interface {
+	// Start starts a span named spanName as a child of the span in ctx, if
+	// any, and returns a context holding the new span.
+	Start(ctx context.Context, spanName string) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	SetAttribute(key string, value interface{})
+	RecordError(err error)
+	End()
+}
+
+// The attributes set on the spans of a DefaultClient.
+const (
+	// AttributeStrainID is the strain ID passed to a method.
+	AttributeStrainID = "strain.id"
+	// AttributeSearchTerm is the name, race, flavor or effect searched for.
+	AttributeSearchTerm = "strain.search_term"
+	// AttributeResourcePath is the path of a resource request, without the
+	// API Key.
+	AttributeResourcePath = "strain.resource_path"
+	// AttributeStatusCode is the HTTP status of a resource request.
+	AttributeStatusCode = "http.status_code"
+	// AttributeCacheHit is whether a resource request was answered by a
+	// middleware added with Use, such as a ResponseCache, without calling
+	// the handler set with SetHandleResourceRequestFunc.
+	AttributeCacheHit = "strain.cache_hit"
+)
+
+// resourceRequestSpanName is the name of the span of every resource request.
+const resourceRequestSpanName string = "GET"
+
+// NoopTracer is a Tracer whose spans do nothing.
+type NoopTracer struct{}
+
+// Start returns ctx and a Span that does nothing.
+func (NoopTracer) Start(ctx context.Context, spanName string) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(key string, value interface{}) {}
+func (noopSpan) RecordError(err error)                      {}
+func (noopSpan) End()                                       {}
+
+// SetTracer sets the Tracer of the DefaultClient and returns the previous
+// value.  Every call of a method of the Client interface gets a span named
+// after the method, such as "SearchStrainsByFlavor", with a child span for
+// each resource request it makes.  Nothing is traced if it is nil, which is
+// the default.
+func (c *DefaultClient) SetTracer(tracer Tracer) Tracer {
+	current := c.tracer
+	c.tracer = tracer
+	return current
+}
+
+// WithContext returns a copy of the DefaultClient, with the same settings,
+// whose spans are children of the span in ctx.  The copy is cheap, so make
+// one per incoming request:
+//
+//	strains, err := client.WithContext(r.Context()).SearchStrainsByFlavor("Earthy")
+func (c *DefaultClient) WithContext(ctx context.Context) *DefaultClient {
+	copied := *c
+	copied.middlewares = append([]Middleware(nil), c.middlewares...)
+	copied.ctx = ctx
+	return &copied
+}
+
+// context returns the context set with WithContext.
+func (c *DefaultClient) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+// startSpan starts the span of a call of a Client method.
+func (c *DefaultClient) startSpan(method string) (context.Context, Span) {
+	if c.tracer == nil {
+		return c.context(), noopSpan{}
+	}
+	return c.tracer.Start(c.context(), method)
+}
+
+// endSpan records err, if any, on span and ends it, returning err.
+func endSpan(span Span, err error) error {
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+	return err
+}
+
+// tracedRequest is a resource request being traced.
+type tracedRequest struct {
+	// reached is set once the request gets to the handler set with
+	// SetHandleResourceRequestFunc.
+	reached int32
+}
+
+// answeredByMiddleware reports whether a middleware, such as a
+// ResponseCache, answered the request without calling the handler.
+func (r *tracedRequest) answeredByMiddleware(err error) bool {
+	return err == nil && atomic.LoadInt32(&r.reached) == 0
+}
+
+// handlerReach tells the traced requests in flight whether they got to the
+// handler set with SetHandleResourceRequestFunc.  It is shared by a
+// DefaultClient and its copies, as they share the composed middlewares.
+type handlerReach struct {
+	mutex   sync.Mutex
+	pending map[string][]*tracedRequest
+}
+
+// wrap returns next marking the requests in flight for the path it is
+// called with as having reached it.  The middlewares are composed once
+// around it.  A HandleResourceRequestFunc only gets a path, so when
+// requests for the same path are in flight at once, all of them are
+// marked, and none is reported as answered by a middleware.
+func (h *handlerReach) wrap(next HandleResourceRequestFunc) HandleResourceRequestFunc {
+	if h == nil || next == nil {
+		return next
+	}
+
+	return func(resourcePath string) ([]byte, error) {
+		h.mutex.Lock()
+		for _, request := range h.pending[resourcePath] {
+			atomic.StoreInt32(&request.reached, 1)
+		}
+		h.mutex.Unlock()
+
+		return next(resourcePath)
+	}
+}
+
+// begin starts tracking a request for resourcePath.
+func (h *handlerReach) begin(resourcePath string) *tracedRequest {
+	request := &tracedRequest{}
+	if h == nil {
+		return request
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.pending[resourcePath] = append(h.pending[resourcePath], request)
+	return request
+}
+
+// end stops tracking request.
+func (h *handlerReach) end(resourcePath string, request *tracedRequest) {
+	if h == nil {
+		return
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	pending := h.pending[resourcePath]
+	for index, other := range pending {
+		if other == request {
+			pending = append(pending[:index], pending[index+1:]...)
+			break
+		}
+	}
+
+	if len(pending) == 0 {
+		delete(h.pending, resourcePath)
+	} else {
+		h.pending[resourcePath] = pending
+	}
+}
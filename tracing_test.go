@@ -0,0 +1,194 @@
+package strainapiclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+type recordedSpan struct {
+	name       string
+	parent     *recordedSpan
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+}
+
+func (s *recordedSpan) SetAttribute(key string, value interface{}) {
+	s.attributes[key] = value
+}
+
+func (s *recordedSpan) RecordError(err error) {
+	s.err = err
+}
+
+func (s *recordedSpan) End() {
+	s.ended = true
+}
+
+type spanContextKey struct{}
+
+// recordingTracer is a Tracer that keeps every span in the order started.
+type recordingTracer struct {
+	mutex sync.Mutex
+	spans []*recordedSpan
+}
+
+func (t *recordingTracer) Start(ctx context.Context, spanName string) (context.Context, strainapiclient.Span) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	parent, _ := ctx.Value(spanContextKey{}).(*recordedSpan)
+	span := &recordedSpan{name: spanName, parent: parent, attributes: make(map[string]interface{})}
+	t.spans = append(t.spans, span)
+
+	return context.WithValue(ctx, spanContextKey{}, span), span
+}
+
+func TestSetTracer(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+	client.Use(
+		strainapiclient.CachingMiddleware(strainapiclient.NewResponseCache(time.Minute)),
+		strainapiclient.StubMiddleware(strainapiclient.Stub{
+			Path: "/strains/data/effects/2",
+			Err:  &strainapiclient.StatusError{StatusCode: http.StatusServiceUnavailable},
+		}),
+	)
+
+	tracer := &recordingTracer{}
+	if previous := client.SetTracer(tracer); previous != nil {
+		t.Errorf("Expected no tracer by default, got %v", previous)
+	}
+
+	ctx, root := tracer.Start(context.Background(), "handler")
+	tracedClient := client.WithContext(ctx)
+
+	_, _ = tracedClient.SearchStrainsByFlavor("Earthy")
+	_, _ = tracedClient.SearchStrainsByFlavor("Earthy")
+	_, err := tracedClient.GetStrainEffectsByStrainID(2)
+
+	if len(tracer.spans) != 7 {
+		t.Fatalf("Expected a span per call and per request under the root span, got %d spans", len(tracer.spans))
+	}
+
+	search, request := tracer.spans[1], tracer.spans[2]
+	if search.name != "SearchStrainsByFlavor" || search.parent != root || search.attributes[strainapiclient.AttributeSearchTerm] != "Earthy" || !search.ended {
+		t.Errorf("Unexpected span for SearchStrainsByFlavor: %+v", search)
+	}
+	if request.name != "GET" || request.parent != search || request.attributes[strainapiclient.AttributeResourcePath] != "/strains/search/flavor/Earthy" ||
+		request.attributes[strainapiclient.AttributeStatusCode] != 200 || request.attributes[strainapiclient.AttributeCacheHit] != false {
+		t.Errorf("Unexpected span for the request of SearchStrainsByFlavor: %+v", request)
+	}
+	if cached := tracer.spans[4]; cached.attributes[strainapiclient.AttributeCacheHit] != true {
+		t.Errorf("Expected the second search to be answered from the cache, got %+v", cached)
+	}
+
+	effects, failed := tracer.spans[5], tracer.spans[6]
+	if effects.name != "GetStrainEffectsByStrainID" || effects.attributes[strainapiclient.AttributeStrainID] != 2 || !errors.Is(effects.err, err) {
+		t.Errorf("Unexpected span for GetStrainEffectsByStrainID: %+v", effects)
+	}
+	if failed.parent != effects || failed.attributes[strainapiclient.AttributeStatusCode] != http.StatusServiceUnavailable || failed.err == nil {
+		t.Errorf("Expected the failed request to record its status and error, got %+v", failed)
+	}
+
+	// The client WithContext was called on is left as it was.
+	_, _ = client.ListAllEffects()
+	if last := tracer.spans[len(tracer.spans)-2]; last.name != "ListAllEffects" || last.parent != nil {
+		t.Errorf("Expected a root span for the original client, got %+v", last)
+	}
+}
+
+func TestSetTracerMarksCacheHitsOfEachRequest(t *testing.T) {
+	cache := strainapiclient.NewResponseCache(time.Minute)
+	cachingClient := clienttest.NewFixtureClient()
+	cachingClient.Use(strainapiclient.CachingMiddleware(cache))
+	if _, err := cachingClient.SearchStrainsByFlavor("Earthy"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Both clients request the same resource path, only one from a cache.
+	cachingTracer, uncachedTracer := &recordingTracer{}, &recordingTracer{}
+	cachingClient.SetTracer(cachingTracer)
+	uncachedClient := clienttest.NewFixtureClient()
+	uncachedClient.SetTracer(uncachedTracer)
+
+	var wait sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wait.Add(2)
+		go func() {
+			defer wait.Done()
+			_, _ = cachingClient.SearchStrainsByFlavor("Earthy")
+		}()
+		go func() {
+			defer wait.Done()
+			_, _ = uncachedClient.SearchStrainsByFlavor("Earthy")
+		}()
+	}
+	wait.Wait()
+
+	for tracer, expected := range map[*recordingTracer]bool{cachingTracer: true, uncachedTracer: false} {
+		for _, span := range tracer.spans {
+			if span.name == "GET" && span.attributes[strainapiclient.AttributeCacheHit] != expected {
+				t.Errorf("Expected %s to be %v, got %+v", strainapiclient.AttributeCacheHit, expected, span)
+			}
+		}
+	}
+}
+
+func TestSetTracerComposesMiddlewaresOnce(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+
+	composed := 0
+	client.Use(func(next strainapiclient.HandleResourceRequestFunc) strainapiclient.HandleResourceRequestFunc {
+		composed++
+		return next
+	})
+	client.SetTracer(&recordingTracer{})
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.ListAllFlavors(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if composed != 1 {
+		t.Errorf("Expected the middleware to be composed once, got %d times", composed)
+	}
+}
+
+func TestWithContextCopiesMiddlewares(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+
+	calls := make([]string, 0)
+	record := func(name string) strainapiclient.Middleware {
+		return func(next strainapiclient.HandleResourceRequestFunc) strainapiclient.HandleResourceRequestFunc {
+			return func(resourcePath string) ([]byte, error) {
+				calls = append(calls, name)
+				return next(resourcePath)
+			}
+		}
+	}
+
+	// Three middlewares leave room in the slice for a fourth.
+	client.Use(record("first"))
+	client.Use(record("second"))
+	client.Use(record("third"))
+
+	copied := client.WithContext(context.Background())
+	copied.Use(record("copy"))
+	client.Use(record("original"))
+	copied.SetHandleResourceRequestFunc(copied.SetHandleResourceRequestFunc(nil))
+
+	if _, err := copied.ListAllFlavors(); err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"first", "second", "third", "copy"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected the copy to call %v, got %v", expected, calls)
+	}
+}
@@ -0,0 +1,243 @@
+package strainapiclient
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Kinds of terms in a Vocabulary.
+const (
+	TermKindEffect = "effect"
+	TermKindFlavor = "flavor"
+)
+
+// UnknownTermError is returned for an effect or flavor that is not in a
+// Vocabulary, with the closest term that is, if any is close enough.
+type UnknownTermError struct {
+	Kind       string
+	Term       string
+	Suggestion string
+}
+
+func (e *UnknownTermError) Error() string {
+	if e.Suggestion == "" {
+		return fmt.Sprintf("Unknown %s %q", e.Kind, e.Term)
+	}
+	return fmt.Sprintf("Unknown %s %q; did you mean %q?", e.Kind, e.Term, e.Suggestion)
+}
+
+// Vocabulary holds every effect and flavor the API knows about, so input
+// can be checked before searching with it rather than silently finding
+// nothing.  Terms are matched without regard to case or surrounding
+// spaces.  It is safe for concurrent use.
+type Vocabulary struct {
+	client Client
+
+	mutex   sync.RWMutex
+	effects map[string]Effect
+	flavors map[string]Flavor
+}
+
+// NewVocabulary creates a Vocabulary of effects and flavors that have
+// already been retrieved.
+func NewVocabulary(effects []Effect, flavors []Flavor) *Vocabulary {
+	v := &Vocabulary{}
+	v.set(effects, flavors)
+	return v
+}
+
+// LoadVocabulary creates a Vocabulary from ListAllEffects and
+// ListAllFlavors.  It keeps them until Refresh is called.
+func LoadVocabulary(client Client) (*Vocabulary, error) {
+	v := &Vocabulary{client: client}
+	if err := v.Refresh(); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Refresh gets the effects and flavors again from the client the Vocabulary
+// was loaded with.  The Vocabulary is left as it was if that fails.
+func (v *Vocabulary) Refresh() error {
+	if v.client == nil {
+		return nil
+	}
+
+	effects, err := v.client.ListAllEffects()
+	if err != nil {
+		return err
+	}
+
+	flavors, err := v.client.ListAllFlavors()
+	if err != nil {
+		return err
+	}
+
+	v.set(effects, flavors)
+	return nil
+}
+
+func (v *Vocabulary) set(effects []Effect, flavors []Flavor) {
+	effectsByName := make(map[string]Effect, len(effects))
+	for _, effect := range effects {
+		effectsByName[vocabularyKey(effect.Name)] = effect
+	}
+
+	flavorsByName := make(map[string]Flavor, len(flavors))
+	for _, flavor := range flavors {
+		flavorsByName[vocabularyKey(string(flavor))] = flavor
+	}
+
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.effects = effectsByName
+	v.flavors = flavorsByName
+}
+
+func vocabularyKey(term string) string {
+	return strings.ToLower(strings.TrimSpace(term))
+}
+
+// Effect returns the Effect named name, as the API spells it, or an
+// *UnknownTermError.
+func (v *Vocabulary) Effect(name string) (Effect, error) {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	if effect, found := v.effects[vocabularyKey(name)]; found {
+		return effect, nil
+	}
+
+	names := make([]string, 0, len(v.effects))
+	for _, effect := range v.effects {
+		names = append(names, effect.Name)
+	}
+	return Effect{}, &UnknownTermError{Kind: TermKindEffect, Term: name, Suggestion: closestTerm(name, names)}
+}
+
+// EffectType returns the EffectType of the effect named name, and whether
+// there is one.
+func (v *Vocabulary) EffectType(name string) (EffectType, bool) {
+	effect, err := v.Effect(name)
+	if err != nil {
+		return "", false
+	}
+	return effect.Type, true
+}
+
+// Flavor returns the Flavor named name, as the API spells it, or an
+// *UnknownTermError.
+func (v *Vocabulary) Flavor(name string) (Flavor, error) {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	if flavor, found := v.flavors[vocabularyKey(name)]; found {
+		return flavor, nil
+	}
+
+	names := make([]string, 0, len(v.flavors))
+	for _, flavor := range v.flavors {
+		names = append(names, string(flavor))
+	}
+	return "", &UnknownTermError{Kind: TermKindFlavor, Term: name, Suggestion: closestTerm(name, names)}
+}
+
+// closestTerm returns the term closest to term by edit distance, ignoring
+// case, or an empty string if none is within a third of its length.  Ties
+// go to the term first in alphabetical order, so the result does not
+// depend on the order of terms.
+func closestTerm(term string, terms []string) string {
+	key := vocabularyKey(term)
+
+	closest := ""
+	closestDistance := len(key)/3 + 1
+	for _, candidate := range terms {
+		distance := editDistance(key, vocabularyKey(candidate))
+		if distance < closestDistance || (distance == closestDistance && closest != "" && candidate < closest) {
+			closest = candidate
+			closestDistance = distance
+		}
+	}
+
+	return closest
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a string, b string) int {
+	first, second := []rune(a), []rune(b)
+
+	previous := make([]int, len(second)+1)
+	current := make([]int, len(second)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(first); i++ {
+		current[0] = i
+		for j := 1; j <= len(second); j++ {
+			cost := 1
+			if first[i-1] == second[j-1] {
+				cost = 0
+			}
+
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(second)]
+}
+
+func minInt(values ...int) int {
+	min := values[0]
+	for _, value := range values[1:] {
+		if value < min {
+			min = value
+		}
+	}
+	return min
+}
+
+// ValidatingClient is a Client that checks the effect and flavor searched
+// for against a Vocabulary first.  Known terms are passed on as the API
+// spells them.  Unknown terms fail with an *UnknownTermError if
+// RejectUnknown is set, and are otherwise passed on as they are.
+type ValidatingClient struct {
+	Client
+	Vocabulary    *Vocabulary
+	RejectUnknown bool
+}
+
+// NewValidatingClient creates a ValidatingClient that rejects unknown
+// terms.
+func NewValidatingClient(client Client, vocabulary *Vocabulary) *ValidatingClient {
+	return &ValidatingClient{Client: client, Vocabulary: vocabulary, RejectUnknown: true}
+}
+
+// SearchStrainsByEffectName searches for effectName once it has been
+// checked against the Vocabulary.
+func (c *ValidatingClient) SearchStrainsByEffectName(effectName string) (SearchStrainsByEffectNameResults, error) {
+	effect, err := c.Vocabulary.Effect(effectName)
+	if err == nil {
+		effectName = effect.Name
+	} else if c.RejectUnknown {
+		return make(SearchStrainsByEffectNameResults, 0), err
+	}
+
+	return c.Client.SearchStrainsByEffectName(effectName)
+}
+
+// SearchStrainsByFlavor searches for flavor once it has been checked
+// against the Vocabulary.
+func (c *ValidatingClient) SearchStrainsByFlavor(flavor Flavor) (SearchStrainsByFlavorResults, error) {
+	known, err := c.Vocabulary.Flavor(string(flavor))
+	if err == nil {
+		flavor = known
+	} else if c.RejectUnknown {
+		return make(SearchStrainsByFlavorResults, 0), err
+	}
+
+	return c.Client.SearchStrainsByFlavor(flavor)
+}
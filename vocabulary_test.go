@@ -0,0 +1,73 @@
+package strainapiclient_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+func TestVocabulary(t *testing.T) {
+	vocabulary, err := strainapiclient.LoadVocabulary(clienttest.NewFixtureClient())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if effect, err := vocabulary.Effect(" dry mouth "); err != nil || effect.Name != "Dry Mouth" {
+		t.Errorf("Expected the effect as the API spells it, got %+v, %v", effect, err)
+	}
+	if effectType, found := vocabulary.EffectType("insomnia"); !found || effectType != strainapiclient.EffectTypeMedical {
+		t.Errorf("Expected insomnia to be medical, got %q, %v", effectType, found)
+	}
+	if flavor, err := vocabulary.Flavor("EARTHY"); err != nil || flavor != "Earthy" {
+		t.Errorf("Expected the flavor as the API spells it, got %q, %v", flavor, err)
+	}
+
+	tests := []struct {
+		term       string
+		lookup     func(term string) error
+		suggestion string
+	}{
+		{"earthie", func(term string) error { _, err := vocabulary.Flavor(term); return err }, "Earthy"},
+		{"Hapy", func(term string) error { _, err := vocabulary.Effect(term); return err }, "Happy"},
+		{"Bubblegum", func(term string) error { _, err := vocabulary.Flavor(term); return err }, ""},
+	}
+
+	for _, test := range tests {
+		var unknown *strainapiclient.UnknownTermError
+		if err := test.lookup(test.term); !errors.As(err, &unknown) || unknown.Term != test.term || unknown.Suggestion != test.suggestion {
+			t.Errorf("%q: expected an UnknownTermError suggesting %q, got %v", test.term, test.suggestion, err)
+		}
+	}
+}
+
+func TestValidatingClient(t *testing.T) {
+	client := clienttest.NewFixtureClient()
+	vocabulary, err := strainapiclient.LoadVocabulary(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requests := make([]string, 0)
+	client.Use(recordingResourcePaths(&requests))
+	validating := strainapiclient.NewValidatingClient(client, vocabulary)
+
+	results, err := validating.SearchStrainsByFlavor("earthy")
+	if err != nil || len(results) != 3 {
+		t.Errorf("Expected a known flavor to be searched as the API spells it, got %v, %v", results, err)
+	}
+
+	var unknown *strainapiclient.UnknownTermError
+	if _, err := validating.SearchStrainsByEffectName("Hapy"); !errors.As(err, &unknown) || unknown.Kind != strainapiclient.TermKindEffect {
+		t.Errorf("Expected an unknown effect to be rejected, got %v", err)
+	}
+	if len(requests) != 1 {
+		t.Errorf("Expected no request for a rejected term, got %v", requests)
+	}
+
+	validating.RejectUnknown = false
+	if results, err := validating.SearchStrainsByEffectName("Hapy"); err != nil || len(results) != 0 {
+		t.Errorf("Expected an unknown effect to be searched for as it is, got %v, %v", results, err)
+	}
+}
@@ -0,0 +1,224 @@
+package watch
+
+import (
+	"sort"
+	"time"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+// EventType is the kind of change an Event describes.
+type EventType string
+
+// The types of Event.
+const (
+	EventStrainAdded   EventType = "strain.added"
+	EventStrainRemoved EventType = "strain.removed"
+	EventStrainChanged EventType = "strain.changed"
+	EventEffectAdded   EventType = "effect.added"
+	EventEffectRemoved EventType = "effect.removed"
+	EventFlavorAdded   EventType = "flavor.added"
+	EventFlavorRemoved EventType = "flavor.removed"
+)
+
+// The fields of a strain listed in the Changes of an EventStrainChanged.
+const (
+	FieldName    = "name"
+	FieldRace    = "race"
+	FieldFlavors = "flavors"
+	FieldEffects = "effects"
+)
+
+// Event is a change to the catalog found by a Watcher.
+type Event struct {
+	// ID is unique to the event, so a webhook receiver can recognize a
+	// delivery it has already seen.
+	ID   string    `json:"id"`
+	Type EventType `json:"type"`
+	Time time.Time `json:"time"`
+
+	// Strain is the strain that was added or changed, or the last known
+	// state of one that was removed.  Strains from ListAllStrains do not
+	// have a description.
+	Strain *strainapiclient.Strain `json:"strain,omitempty"`
+	// Previous is the state of a changed strain before the change.
+	Previous *strainapiclient.Strain `json:"previous,omitempty"`
+	// Changes lists the fields of a changed strain that are different.
+	Changes []string `json:"changes,omitempty"`
+
+	Effect *strainapiclient.Effect `json:"effect,omitempty"`
+	Flavor strainapiclient.Flavor  `json:"flavor,omitempty"`
+}
+
+// catalog is the state of the catalog at one poll.
+type catalog struct {
+	strains map[int]strainapiclient.Strain
+	effects map[strainapiclient.Effect]bool
+	flavors map[strainapiclient.Flavor]bool
+}
+
+func newCatalog(strains strainapiclient.ListAllStrainsResult, effects []strainapiclient.Effect, flavors []strainapiclient.Flavor) *catalog {
+	c := &catalog{
+		strains: make(map[int]strainapiclient.Strain, len(strains)),
+		effects: make(map[strainapiclient.Effect]bool, len(effects)),
+		flavors: make(map[strainapiclient.Flavor]bool, len(flavors)),
+	}
+
+	for _, strain := range strains {
+		c.strains[strain.ID] = strain
+	}
+	for _, effect := range effects {
+		c.effects[effect] = true
+	}
+	for _, flavor := range flavors {
+		c.flavors[flavor] = true
+	}
+
+	return c
+}
+
+// diff returns the events that turn previous into c: strains in order of ID,
+// then effects and flavors in order of name.  The events do not have an ID
+// or Time yet.
+func (c *catalog) diff(previous *catalog) []Event {
+	events := make([]Event, 0)
+
+	ids := make([]int, 0, len(c.strains)+len(previous.strains))
+	for id := range c.strains {
+		ids = append(ids, id)
+	}
+	for id := range previous.strains {
+		if _, found := c.strains[id]; !found {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+
+	for _, id := range ids {
+		current, isCurrent := c.strains[id]
+		old, wasPrevious := previous.strains[id]
+
+		switch {
+		case isCurrent && !wasPrevious:
+			events = append(events, Event{Type: EventStrainAdded, Strain: &current})
+		case !isCurrent && wasPrevious:
+			events = append(events, Event{Type: EventStrainRemoved, Strain: &old})
+		default:
+			if changes := strainChanges(old, current); len(changes) > 0 {
+				events = append(events, Event{Type: EventStrainChanged, Strain: &current, Previous: &old, Changes: changes})
+			}
+		}
+	}
+
+	for _, effect := range sortedEffects(c.effects, previous.effects) {
+		effect := effect
+		events = append(events, Event{Type: EventEffectAdded, Effect: &effect})
+	}
+	for _, effect := range sortedEffects(previous.effects, c.effects) {
+		effect := effect
+		events = append(events, Event{Type: EventEffectRemoved, Effect: &effect})
+	}
+
+	for _, flavor := range sortedFlavors(c.flavors, previous.flavors) {
+		events = append(events, Event{Type: EventFlavorAdded, Flavor: flavor})
+	}
+	for _, flavor := range sortedFlavors(previous.flavors, c.flavors) {
+		events = append(events, Event{Type: EventFlavorRemoved, Flavor: flavor})
+	}
+
+	return events
+}
+
+// strainChanges lists the fields that differ between two states of a
+// strain.  The order of flavors and effects does not matter.
+func strainChanges(old strainapiclient.Strain, current strainapiclient.Strain) []string {
+	changes := make([]string, 0)
+
+	if old.Name != current.Name {
+		changes = append(changes, FieldName)
+	}
+	if old.Race != current.Race {
+		changes = append(changes, FieldRace)
+	}
+
+	oldFlavors := make([]string, len(old.Flavors))
+	for index, flavor := range old.Flavors {
+		oldFlavors[index] = string(flavor)
+	}
+	currentFlavors := make([]string, len(current.Flavors))
+	for index, flavor := range current.Flavors {
+		currentFlavors[index] = string(flavor)
+	}
+	if !sameStrings(oldFlavors, currentFlavors) {
+		changes = append(changes, FieldFlavors)
+	}
+
+	effectTypes := make(map[strainapiclient.EffectType]bool)
+	for effectType := range old.Effects {
+		effectTypes[effectType] = true
+	}
+	for effectType := range current.Effects {
+		effectTypes[effectType] = true
+	}
+	for effectType := range effectTypes {
+		if !sameStrings(old.Effects[effectType], current.Effects[effectType]) {
+			changes = append(changes, FieldEffects)
+			break
+		}
+	}
+
+	return changes
+}
+
+// sameStrings reports whether a and b hold the same strings in any order.
+func sameStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[string]int, len(a))
+	for _, value := range a {
+		counts[value]++
+	}
+	for _, value := range b {
+		counts[value]--
+		if counts[value] < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sortedEffects returns the effects in set that are not in other.
+func sortedEffects(set map[strainapiclient.Effect]bool, other map[strainapiclient.Effect]bool) []strainapiclient.Effect {
+	result := make([]strainapiclient.Effect, 0)
+	for effect := range set {
+		if !other[effect] {
+			result = append(result, effect)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name != result[j].Name {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].Type < result[j].Type
+	})
+
+	return result
+}
+
+// sortedFlavors returns the flavors in set that are not in other.
+func sortedFlavors(set map[strainapiclient.Flavor]bool, other map[strainapiclient.Flavor]bool) []strainapiclient.Flavor {
+	result := make([]strainapiclient.Flavor, 0)
+	for flavor := range set {
+		if !other[flavor] {
+			result = append(result, flavor)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+
+	return result
+}
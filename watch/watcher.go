@@ -0,0 +1,206 @@
+// Package watch tells you when the catalog of The Strain API changes.  A
+// Watcher pulls ListAllStrains, ListAllEffects and ListAllFlavors, compares
+// them with what it saw last time and sends an Event for every strain,
+// effect and flavor that was added, removed or changed to the callbacks and
+// webhooks registered with it:
+//
+//	watcher := watch.NewWatcher(client)
+//	watcher.OnEvent(func(event watch.Event) {
+//		log.Printf("%s: %s", event.Type, event.Strain.Name)
+//	})
+//	watcher.AddWebhook(watch.Webhook{URL: "https://example.com/hooks/strains", Secret: secret})
+//	stop := watcher.WatchEvery(time.Hour, func(err error) { log.Print(err) })
+//	defer stop()
+//
+// The first poll only records the catalog; events start with the second.
+package watch
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/tchype/strainapiclient-go"
+)
+
+// Watcher polls a Client for changes to the catalog.  It is safe for
+// concurrent use.
+type Watcher struct {
+	client strainapiclient.Client
+
+	mutex       sync.Mutex
+	callbacks   []func(Event)
+	webhooks    []*webhookQueue
+	retryPolicy RetryPolicy
+	httpClient  *http.Client
+	last        *catalog
+
+	pollMutex  sync.Mutex
+	deliveries sync.WaitGroup
+	now        func() time.Time
+}
+
+// NewWatcher creates a Watcher of the catalog of client with the
+// DefaultRetryPolicy for webhooks.
+func NewWatcher(client strainapiclient.Client) *Watcher {
+	return &Watcher{
+		client:      client,
+		retryPolicy: DefaultRetryPolicy,
+		httpClient:  &http.Client{Timeout: 30 * time.Second},
+		now:         time.Now,
+	}
+}
+
+// OnEvent registers a function that is called with every Event, in order,
+// on the goroutine that polls.
+func (w *Watcher) OnEvent(callback func(Event)) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.callbacks = append(w.callbacks, callback)
+}
+
+// AddWebhook registers a URL that every Event is posted to, in order, one
+// at a time.
+func (w *Watcher) AddWebhook(webhook Webhook) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.webhooks = append(w.webhooks, &webhookQueue{webhook: webhook})
+}
+
+// SetRetryPolicy sets how failed webhook deliveries are retried and returns
+// the previous value.
+func (w *Watcher) SetRetryPolicy(retryPolicy RetryPolicy) RetryPolicy {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	previous := w.retryPolicy
+	w.retryPolicy = retryPolicy
+	return previous
+}
+
+// SetHTTPClient sets the http.Client webhooks are posted with and returns
+// the previous value.
+func (w *Watcher) SetHTTPClient(httpClient *http.Client) *http.Client {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	previous := w.httpClient
+	w.httpClient = httpClient
+	return previous
+}
+
+// Poll pulls the catalog, sends the events for what changed since the last
+// poll and returns them.  Nothing is recorded if any of the lists cannot be
+// retrieved, so the next successful poll reports the changes instead.
+//
+// Webhooks are delivered in the background; use Wait to wait for them.
+// Each webhook gets the events of a poll after those of earlier polls.
+func (w *Watcher) Poll() ([]Event, error) {
+	w.pollMutex.Lock()
+	defer w.pollMutex.Unlock()
+
+	strains, err := w.client.ListAllStrains()
+	if err != nil {
+		return nil, err
+	}
+	effects, err := w.client.ListAllEffects()
+	if err != nil {
+		return nil, err
+	}
+	flavors, err := w.client.ListAllFlavors()
+	if err != nil {
+		return nil, err
+	}
+
+	current := newCatalog(strains, effects, flavors)
+
+	w.mutex.Lock()
+	previous := w.last
+	w.last = current
+	callbacks := append([]func(Event){}, w.callbacks...)
+	webhooks := append([]*webhookQueue{}, w.webhooks...)
+	retryPolicy := w.retryPolicy
+	httpClient := w.httpClient
+	w.mutex.Unlock()
+
+	if previous == nil {
+		return make([]Event, 0), nil
+	}
+
+	events := current.diff(previous)
+	now := w.now()
+	for index := range events {
+		events[index].ID = newEventID()
+		events[index].Time = now
+	}
+
+	for _, event := range events {
+		for _, callback := range callbacks {
+			callback(event)
+		}
+	}
+
+	if len(events) > 0 {
+		for _, queue := range webhooks {
+			w.deliveries.Add(1)
+			queue.enqueue(delivery{events: events, httpClient: httpClient, retryPolicy: retryPolicy, done: w.deliveries.Done})
+		}
+	}
+
+	return events, nil
+}
+
+// Wait waits for the webhook deliveries of earlier polls, including their
+// retries, to finish.
+func (w *Watcher) Wait() {
+	w.deliveries.Wait()
+}
+
+// WatchEvery polls now and then every interval until stop is called.
+// Failed polls are passed to onError, which may be nil.  stop waits for
+// the webhook deliveries in progress.
+func (w *Watcher) WatchEvery(interval time.Duration, onError func(error)) (stop func()) {
+	poll := func() {
+		if _, err := w.Poll(); err != nil && onError != nil {
+			onError(err)
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		poll()
+		for {
+			select {
+			case <-ticker.C:
+				poll()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			ticker.Stop()
+			close(done)
+			<-stopped
+			w.Wait()
+		})
+	}
+}
+
+func newEventID() string {
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,326 @@
+package watch
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tchype/strainapiclient-go"
+	"github.com/tchype/strainapiclient-go/clienttest"
+)
+
+// changingCatalog is a fixture client whose dataset can be changed between
+// polls.
+type changingCatalog struct {
+	mutex   sync.Mutex
+	dataset clienttest.Dataset
+	err     error
+}
+
+func newChangingCatalog() (*strainapiclient.DefaultClient, *changingCatalog) {
+	c := &changingCatalog{dataset: clienttest.KnownDataset()}
+
+	client := strainapiclient.NewDefaultClient(clienttest.FixtureAPIKey)
+	client.SetHandleResourceRequestFunc(func(resourcePath string) ([]byte, error) {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		if c.err != nil {
+			return nil, c.err
+		}
+		return c.dataset.HandleResourceRequest(resourcePath)
+	})
+
+	return client, c
+}
+
+func (c *changingCatalog) change(f func(dataset *clienttest.Dataset)) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	f(&c.dataset)
+}
+
+func eventSummaries(events []Event) []string {
+	summaries := make([]string, len(events))
+	for index, event := range events {
+		summary := string(event.Type) + " "
+		switch {
+		case event.Strain != nil:
+			summary += event.Strain.Name
+		case event.Effect != nil:
+			summary += event.Effect.Name
+		default:
+			summary += string(event.Flavor)
+		}
+		summaries[index] = summary
+	}
+	return summaries
+}
+
+func TestPollReportsChanges(t *testing.T) {
+	client, catalog := newChangingCatalog()
+	watcher := NewWatcher(client)
+
+	received := make([]Event, 0)
+	watcher.OnEvent(func(event Event) {
+		received = append(received, event)
+	})
+
+	if events, err := watcher.Poll(); err != nil || len(events) != 0 {
+		t.Fatalf("Expected the first poll to only record the catalog, got %v, %v", events, err)
+	}
+
+	catalog.change(func(dataset *clienttest.Dataset) {
+		// Remove Afghani, change Blueberry and add a new strain, effect and flavor.
+		dataset.Strains = append(dataset.Strains[:1], dataset.Strains[2:]...)
+		dataset.Strains[2].Race = strainapiclient.RaceHybrid
+		dataset.Strains[2].Flavors = []strainapiclient.Flavor{"Sweet", "Berry"}
+		dataset.Strains[2].Effects[strainapiclient.EffectTypeNegative] = []string{"Dry Mouth", "Paranoid"}
+		dataset.Strains = append(dataset.Strains, strainapiclient.Strain{
+			Name: "Mango Kush", ID: 6, Race: strainapiclient.RaceHybrid, Flavors: []strainapiclient.Flavor{"Mango"},
+		})
+		dataset.Effects = append(dataset.Effects, strainapiclient.Effect{Name: "Tingly", Type: strainapiclient.EffectTypePositive})
+		dataset.Flavors = append(dataset.Flavors[1:], "Mango")
+	})
+
+	events, err := watcher.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"strain.removed Afghani",
+		"strain.changed Blueberry",
+		"strain.added Mango Kush",
+		"effect.added Tingly",
+		"flavor.added Mango",
+		"flavor.removed Earthy",
+	}
+	if diff := cmp.Diff(expected, eventSummaries(events)); diff != "" {
+		t.Errorf("Unexpected events (-expected +actual):\n%s", diff)
+	}
+	if diff := cmp.Diff(events, received); diff != "" {
+		t.Errorf("Expected the callback to receive every event (-returned +received):\n%s", diff)
+	}
+
+	changed := events[1]
+	if diff := cmp.Diff([]string{FieldRace, FieldEffects}, changed.Changes); diff != "" {
+		t.Errorf("Expected the race and effects of Blueberry to have changed, not its reordered flavors (-expected +actual):\n%s", diff)
+	}
+	if changed.Previous == nil || changed.Previous.Race != strainapiclient.RaceIndica || changed.Strain.Race != strainapiclient.RaceHybrid {
+		t.Errorf("Expected the previous and current state of Blueberry, got %+v", changed)
+	}
+	if changed.ID == "" || changed.ID == events[0].ID || changed.Time.IsZero() {
+		t.Errorf("Expected every event to have its own ID and a time, got %+v", events)
+	}
+
+	if events, err := watcher.Poll(); err != nil || len(events) != 0 {
+		t.Errorf("Expected no events without changes, got %v, %v", eventSummaries(events), err)
+	}
+}
+
+func TestFailedPollKeepsLastCatalog(t *testing.T) {
+	client, catalog := newChangingCatalog()
+	watcher := NewWatcher(client)
+
+	if _, err := watcher.Poll(); err != nil {
+		t.Fatal(err)
+	}
+
+	catalog.change(func(dataset *clienttest.Dataset) {
+		dataset.Flavors = append(dataset.Flavors, "Mango")
+	})
+	catalog.err = &strainapiclient.ConnectionError{Err: errors.New("connection refused")}
+
+	if _, err := watcher.Poll(); err == nil {
+		t.Fatal("Expected the poll to fail")
+	}
+
+	catalog.err = nil
+	events, err := watcher.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"flavor.added Mango"}, eventSummaries(events)); diff != "" {
+		t.Errorf("Expected the change to be reported after the failed poll (-expected +actual):\n%s", diff)
+	}
+}
+
+func TestWebhookDeliveryIsSignedAndRetried(t *testing.T) {
+	const secret string = "webhook-secret"
+
+	var mutex sync.Mutex
+	attempts := 0
+	delivered := make([]Event, 0)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		attempts++
+		if attempts <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		if !VerifySignature(secret, body, r.Header.Get(SignatureHeader)) {
+			t.Errorf("Expected a valid signature, got %q", r.Header.Get(SignatureHeader))
+		}
+
+		var event Event
+		if err := json.Unmarshal(body, &event); err != nil {
+			t.Errorf("Expected an event, got %s", body)
+		}
+		if r.Header.Get(EventHeader) != string(event.Type) || r.Header.Get(DeliveryHeader) != event.ID {
+			t.Errorf("Expected the event type and ID headers, got %v", r.Header)
+		}
+		delivered = append(delivered, event)
+	}))
+	defer server.Close()
+
+	client, catalog := newChangingCatalog()
+	watcher := NewWatcher(client)
+	watcher.SetRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+
+	failures := make([]*DeliveryError, 0)
+	watcher.AddWebhook(Webhook{URL: server.URL, Secret: secret, OnFailure: func(err *DeliveryError) {
+		failures = append(failures, err)
+	}})
+
+	if _, err := watcher.Poll(); err != nil {
+		t.Fatal(err)
+	}
+
+	catalog.change(func(dataset *clienttest.Dataset) {
+		dataset.Flavors = append(dataset.Flavors, "Mango", "Vanilla")
+	})
+	events, err := watcher.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	watcher.Wait()
+
+	if len(failures) != 0 {
+		t.Errorf("Expected the deliveries to succeed on the third attempt, got %v", failures)
+	}
+	if diff := cmp.Diff(events, delivered, cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) })); diff != "" {
+		t.Errorf("Expected every event to be delivered in order (-expected +delivered):\n%s", diff)
+	}
+}
+
+func TestWebhookDeliveriesStayInOrderAcrossPolls(t *testing.T) {
+	var mutex sync.Mutex
+	delivered := make([]string, 0)
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event Event
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &event); err != nil {
+			t.Errorf("Expected an event, got %s", body)
+		}
+
+		// Hold the first delivery until the next poll has been made.
+		if event.Flavor == "Mango" {
+			<-release
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		delivered = append(delivered, string(event.Flavor))
+	}))
+	defer server.Close()
+
+	client, catalog := newChangingCatalog()
+	watcher := NewWatcher(client)
+	watcher.AddWebhook(Webhook{URL: server.URL})
+
+	if _, err := watcher.Poll(); err != nil {
+		t.Fatal(err)
+	}
+	for _, flavor := range []strainapiclient.Flavor{"Mango", "Vanilla"} {
+		catalog.change(func(dataset *clienttest.Dataset) {
+			dataset.Flavors = append(dataset.Flavors, flavor)
+		})
+		if _, err := watcher.Poll(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	watcher.Wait()
+
+	if expected := []string{"Mango", "Vanilla"}; !cmp.Equal(delivered, expected) {
+		t.Errorf("Expected the events of each poll to be delivered after the ones before, got %v", delivered)
+	}
+}
+
+func TestWebhookDeliveryFailure(t *testing.T) {
+	tests := []struct {
+		status           int
+		expectedAttempts int
+	}{
+		{http.StatusInternalServerError, 3},
+		{http.StatusBadRequest, 1},
+	}
+
+	for _, test := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(test.status)
+		}))
+
+		client, catalog := newChangingCatalog()
+		watcher := NewWatcher(client)
+		watcher.SetRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+
+		failures := make(chan *DeliveryError, 1)
+		watcher.AddWebhook(Webhook{URL: server.URL, OnFailure: func(err *DeliveryError) {
+			failures <- err
+		}})
+
+		if _, err := watcher.Poll(); err != nil {
+			t.Fatal(err)
+		}
+		catalog.change(func(dataset *clienttest.Dataset) {
+			dataset.Flavors = append(dataset.Flavors, "Mango")
+		})
+		if _, err := watcher.Poll(); err != nil {
+			t.Fatal(err)
+		}
+		watcher.Wait()
+		server.Close()
+
+		select {
+		case failure := <-failures:
+			if failure.Attempts != test.expectedAttempts || failure.Event.Flavor != "Mango" {
+				t.Errorf("Status %d: expected %d attempts to deliver the Mango event, got %v", test.status, test.expectedAttempts, failure)
+			}
+		default:
+			t.Errorf("Status %d: expected the delivery to fail", test.status)
+		}
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(`{"type":"strain.added"}`)
+	signature := Sign("secret", body)
+
+	if !VerifySignature("secret", body, signature) {
+		t.Error("Expected the signature to verify")
+	}
+	if VerifySignature("other secret", body, signature) || VerifySignature("secret", []byte("{}"), signature) {
+		t.Error("Expected the signature to fail with another secret or body")
+	}
+	if VerifySignature("secret", body, signature[len("sha256="):]) {
+		t.Error("Expected a signature without its prefix to fail")
+	}
+}
@@ -0,0 +1,205 @@
+package watch
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The headers of a webhook delivery.
+const (
+	// EventHeader holds the EventType of the delivered Event.
+	EventHeader string = "X-Strain-Event"
+	// DeliveryHeader holds the ID of the delivered Event, which is the same
+	// for every attempt to deliver it.
+	DeliveryHeader string = "X-Strain-Delivery"
+	// SignatureHeader holds "sha256=" and the hex HMAC-SHA256 of the body
+	// with the Secret of the Webhook.
+	SignatureHeader string = "X-Strain-Signature"
+)
+
+const signaturePrefix string = "sha256="
+
+// Webhook is a URL each Event is posted to as JSON, one request per event.
+type Webhook struct {
+	URL string
+	// Secret signs the deliveries, see SignatureHeader.  Deliveries are not
+	// signed if it is empty.
+	Secret string
+	// OnFailure, which may be nil, is called with each Event that could
+	// not be delivered.
+	OnFailure func(*DeliveryError)
+}
+
+// RetryPolicy is how a failed webhook delivery is retried.  Deliveries that
+// fail to connect, time out or get a 408, 429 or 5xx status are retried,
+// waiting InitialBackoff before the second attempt and twice as long before
+// every further one, up to MaxBackoff.
+type RetryPolicy struct {
+	// MaxAttempts is the number of times a delivery is attempted, at least 1.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryPolicy tries a delivery five times over about 15 seconds.
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Second, MaxBackoff: time.Minute}
+
+// DeliveryError is an Event that could not be delivered to a Webhook.
+type DeliveryError struct {
+	URL      string
+	Event    Event
+	Attempts int
+	// Err is the error of the last attempt.
+	Err error
+}
+
+func (e *DeliveryError) Error() string {
+	return fmt.Sprintf("Unable to deliver event %s to %s after %d attempts: %s", e.Event.ID, e.URL, e.Attempts, e.Err)
+}
+
+// Unwrap returns the error of the last attempt.
+func (e *DeliveryError) Unwrap() error {
+	return e.Err
+}
+
+// Sign returns the value of the SignatureHeader for body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether signature, the value of the
+// SignatureHeader of a delivery, is the signature of body with secret.
+// Webhook receivers should check it before trusting a delivery.
+func VerifySignature(secret string, body []byte, signature string) bool {
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// deliver posts the events in order, reporting the ones that fail.
+func (w Webhook) deliver(httpClient *http.Client, retryPolicy RetryPolicy, events []Event) {
+	for _, event := range events {
+		if err := w.deliverEvent(httpClient, retryPolicy, event); err != nil && w.OnFailure != nil {
+			w.OnFailure(err)
+		}
+	}
+}
+
+// delivery is the events of one poll to be delivered to a webhook.
+type delivery struct {
+	events      []Event
+	httpClient  *http.Client
+	retryPolicy RetryPolicy
+	done        func()
+}
+
+// webhookQueue delivers to a webhook one poll after another, so its events
+// arrive in order even when a delivery is still being retried at the next
+// poll.
+type webhookQueue struct {
+	webhook Webhook
+
+	mutex   sync.Mutex
+	pending []delivery
+	running bool
+}
+
+// enqueue adds a delivery, starting a goroutine to make it unless one is
+// already making earlier ones.
+func (q *webhookQueue) enqueue(d delivery) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.pending = append(q.pending, d)
+	if !q.running {
+		q.running = true
+		go q.run()
+	}
+}
+
+// run makes the pending deliveries in order until there are none left.
+func (q *webhookQueue) run() {
+	for {
+		q.mutex.Lock()
+		if len(q.pending) == 0 {
+			q.running = false
+			q.mutex.Unlock()
+			return
+		}
+		d := q.pending[0]
+		q.pending = q.pending[1:]
+		q.mutex.Unlock()
+
+		q.webhook.deliver(d.httpClient, d.retryPolicy, d.events)
+		d.done()
+	}
+}
+
+func (w Webhook) deliverEvent(httpClient *http.Client, retryPolicy RetryPolicy, event Event) *DeliveryError {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return &DeliveryError{URL: w.URL, Event: event, Err: err}
+	}
+
+	backoff := retryPolicy.InitialBackoff
+	attempts := 0
+	for {
+		attempts++
+
+		retry, err := w.post(httpClient, event, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempts >= retryPolicy.MaxAttempts {
+			return &DeliveryError{URL: w.URL, Event: event, Attempts: attempts, Err: err}
+		}
+
+		time.Sleep(backoff)
+		backoff *= 2
+		if backoff > retryPolicy.MaxBackoff {
+			backoff = retryPolicy.MaxBackoff
+		}
+	}
+}
+
+// post makes one attempt at a delivery and reports whether a failure is
+// worth retrying.
+func (w Webhook) post(httpClient *http.Client, event Event, body []byte) (bool, error) {
+	request, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(EventHeader, string(event.Type))
+	request.Header.Set(DeliveryHeader, event.ID)
+	if w.Secret != "" {
+		request.Header.Set(SignatureHeader, Sign(w.Secret, body))
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return true, err
+	}
+	response.Body.Close()
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return false, nil
+	}
+
+	retry := response.StatusCode >= 500 ||
+		response.StatusCode == http.StatusRequestTimeout ||
+		response.StatusCode == http.StatusTooManyRequests
+	return retry, fmt.Errorf("Status: %d", response.StatusCode)
+}